@@ -0,0 +1,219 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+
+	"github.com/irismod/nft/types"
+)
+
+// GetCmdGrantAuthorization is the CLI command for a GrantAuthorization transaction
+func GetCmdGrantAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "grant-authorization [grantee] [transfer|mint|edit|burn] [denomID]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant the grantee the authorization to execute NFT messages of a denom on behalf of the granter until the expiration.
+Example:
+$ %s tx nft grant-authorization [grantee] transfer [denomID] --token-ids=<token-id>,<token-id> --expiration=<RFC3339> --from=<key-name> --chain-id=<chain-id> --fees=<fee>
+$ %s tx nft grant-authorization [grantee] mint [denomID] --spend-limit=<limit> --expiration=<RFC3339> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			authorization, err := newAuthorization(args[1], args[2])
+			if err != nil {
+				return err
+			}
+
+			expiration := time.Now().AddDate(1, 0, 0)
+			if expirationStr := strings.TrimSpace(viper.GetString(FlagExpiration)); len(expirationStr) > 0 {
+				if expiration, err = time.Parse(time.RFC3339, expirationStr); err != nil {
+					return fmt.Errorf("invalid expiration %s: %w", expirationStr, err)
+				}
+			}
+
+			msg, err := types.NewMsgGrantAuthorization(clientCtx.GetFromAddress().String(), args[0], authorization, expiration)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsGrant)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRevokeAuthorization is the CLI command for a RevokeAuthorization transaction
+func GetCmdRevokeAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "revoke-authorization [grantee] [transfer|mint|edit|burn]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke the authorization granted to the grantee.
+Example:
+$ %s tx nft revoke-authorization [grantee] transfer --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msgTypeURL, err := authorizationMsgTypeURL(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeAuthorization(clientCtx.GetFromAddress().String(), args[0], msgTypeURL)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdExecAuthorized is the CLI command for an ExecAuthorized transaction
+func GetCmdExecAuthorized() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "exec-authorized [tx-json-file]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Execute the NFT messages of a transaction generated by their granter, with the authorizations granted to the sender.
+Example:
+$ %s tx nft transfer [recipient] [denomID] [tokenID] --from=<granter> --generate-only > tx.json
+$ %s tx nft exec-authorized tx.json --from=<grantee> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			stdTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgExecAuthorized(clientCtx.GetFromAddress().String(), stdTx.GetMsgs())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryGrants queries the authorizations granted by an account to another
+func GetCmdQueryGrants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "grants [granter] [grantee] [transfer|mint|edit|burn]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the authorizations granted by the granter to the grantee, optionally only the one of a message.
+Example:
+$ %s query nft grants [granter] [grantee]
+$ %s query nft grants [granter] [grantee] transfer`, version.AppName, version.AppName)),
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+			if _, err := sdk.AccAddressFromBech32(args[1]); err != nil {
+				return err
+			}
+
+			var msgTypeURL string
+			if len(args) == 3 {
+				if msgTypeURL, err = authorizationMsgTypeURL(args[2]); err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Grants(context.Background(), &types.QueryGrantsRequest{
+				Granter:    args[0],
+				Grantee:    args[1],
+				MsgTypeURL: msgTypeURL,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintOutput(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// newAuthorization builds the authorization of the given kind from the flags
+func newAuthorization(kind, denomID string) (types.Authorization, error) {
+	tokenIDs := viper.GetStringSlice(FlagTokenIDs)
+	switch strings.ToLower(kind) {
+	case "transfer":
+		return types.NewTransferAuthorization(denomID, tokenIDs), nil
+	case "mint":
+		return types.NewMintAuthorization(denomID, viper.GetUint64(FlagSpendLimit)), nil
+	case "edit":
+		return types.NewEditAuthorization(denomID, tokenIDs), nil
+	case "burn":
+		return types.NewBurnAuthorization(denomID, tokenIDs), nil
+	default:
+		return nil, fmt.Errorf("unknown authorization %s, expected transfer, mint, edit or burn", kind)
+	}
+}
+
+// authorizationMsgTypeURL returns the type url of the message of the given
+// kind of authorization
+func authorizationMsgTypeURL(kind string) (string, error) {
+	authorization, err := newAuthorization(kind, "")
+	if err != nil {
+		return "", err
+	}
+	return authorization.MsgTypeURL(), nil
+}
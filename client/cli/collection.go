@@ -0,0 +1,322 @@
+package cli
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/irismod/nft/client/nftclient"
+	"github.com/irismod/nft/types"
+)
+
+// GetCmdExportCollection writes the NFTs of a collection as JSON or CSV
+func GetCmdExportCollection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "export-collection [denomID]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Write every NFT of a collection to the standard output as a JSON array or as CSV, with the
+columns %s. The collection is queried page by page, every page at the height of the first one.
+The token uris are written as stored, relative ones are not resolved against the base uri of the denom.
+The output is readable by import-collection and add-genesis-nft.
+Example:
+$ %s query nft export-collection <denom> --format=csv > collection.csv`,
+				strings.Join(nftColumns, ","), version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom := strings.ToLower(strings.TrimSpace(args[0]))
+			if err := types.ValidateDenomID(denom); err != nil {
+				return err
+			}
+
+			writer, err := newNFTRowWriter(cmd.OutOrStdout(), viper.GetString(FlagFormat))
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			err = exportCollection(context.Background(), queryClient, denom, viper.GetUint64(FlagPageLimit), func(nft types.BaseNFT) error {
+				return writer.Write(nftRow{
+					DenomID:    denom,
+					TokenID:    nft.Id,
+					Owner:      nft.Owner.String(),
+					Name:       nft.Name,
+					URI:        nft.URI,
+					URIHash:    nft.URIHash,
+					Data:       nft.Data,
+					Attributes: nft.Attributes,
+				})
+			})
+			if err != nil {
+				return err
+			}
+			return writer.Close()
+		},
+	}
+	cmd.Flags().AddFlagSet(FsExportCollection)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// exportCollection passes the NFTs of the denom to write page by page, the
+// pages following the first one are queried at its height so that the export
+// is a consistent view of the collection. The token uris are exported as
+// stored, not resolved against the base uri, so that importing the export
+// mints the same NFTs.
+func exportCollection(ctx context.Context, queryClient types.QueryClient, denom string, limit uint64, write func(types.BaseNFT) error) error {
+	var nextKey []byte
+	for {
+		var header metadata.MD
+		res, err := queryClient.Collection(ctx, &types.QueryCollectionRequest{
+			Denom:      denom,
+			Pagination: &query.PageRequest{Key: nextKey, Limit: limit},
+			RawURIs:    true,
+		}, grpc.Header(&header))
+		if err != nil {
+			return err
+		}
+		if heights := header.Get(grpctypes.GRPCBlockHeightHeader); len(heights) > 0 && len(nextKey) == 0 {
+			ctx = metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, heights[0])
+		}
+
+		for _, nft := range res.Collection.NFTs {
+			if err := write(nft); err != nil {
+				return err
+			}
+		}
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return nil
+		}
+		nextKey = res.Pagination.NextKey
+	}
+}
+
+// GetCmdImportCollection mints the NFTs of a file in batches of transactions
+func GetCmdImportCollection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "import-collection [file]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Mint the NFTs of a JSON or CSV file as written by export-collection, in transactions of
+--batch-size mints signed by --from, each one waited for before sending the next one. The NFTs are minted
+to their owner, or to the sender when the owner is empty, in their denom unless --denom is set. The denom
+must exist and the sender must be allowed to mint in it.
+The number of NFTs minted is recorded in a progress file before and after every transaction, running
+the command again resumes the import after the last recorded transaction, skipping the NFTs of an
+interrupted transaction that were minted anyway.
+Example:
+$ %s tx nft import-collection collection.csv --denom=<denom> --batch-size=50 --from=<key-name> --chain-id=<chain-id> --gas-prices=<gas-prices>`,
+				version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			rows, err := readNFTRows(args[0])
+			if err != nil {
+				return err
+			}
+			if denom := strings.TrimSpace(viper.GetString(FlagDenom)); len(denom) > 0 {
+				for i := range rows {
+					rows[i].DenomID = denom
+				}
+			}
+
+			progressFile := viper.GetString(FlagProgressFile)
+			if len(progressFile) == 0 {
+				progressFile = args[0] + ".progress"
+			}
+			progress, err := loadImportProgress(progressFile, args[0])
+			if err != nil {
+				return err
+			}
+
+			nftClient, err := nftclient.New(nftclient.Config{
+				ChainID:       clientCtx.ChainID,
+				NodeURI:       clientCtx.NodeURI,
+				GasPrices:     viper.GetString(flags.FlagGasPrices),
+				GasAdjustment: viper.GetFloat64(flags.FlagGasAdjustment),
+			}, clientCtx, clientCtx.Keyring)
+			if err != nil {
+				return err
+			}
+			sender := clientCtx.GetFromAddress()
+
+			ctx := context.Background()
+			importer := collectionImporter{
+				batchSize: viper.GetInt(FlagBatchSize),
+				exists: func(row nftRow) (bool, error) {
+					_, err := nftClient.GetNFT(ctx, strings.ToLower(row.DenomID), strings.ToLower(row.TokenID))
+					if err != nil && strings.Contains(err.Error(), types.ErrUnknownNFT.Error()) {
+						return false, nil
+					}
+					return err == nil, err
+				},
+				mint: func(batch []nftRow) error {
+					msgs := make([]sdk.Msg, len(batch))
+					for i, row := range batch {
+						recipient := row.Owner
+						if len(recipient) == 0 {
+							recipient = sender.String()
+						}
+						msgs[i] = types.NewMsgMintNFT(row.TokenID, strings.ToLower(row.DenomID), row.Name, row.URI, row.URIHash, row.Data,
+							row.Attributes, sender.String(), recipient)
+					}
+					_, err := nftClient.Broadcast(ctx, clientCtx.GetFromName(), msgs...)
+					return err
+				},
+				out: cmd.OutOrStdout(),
+			}
+			return importer.run(rows, progress, progressFile)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsImportCollection)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// importProgress is the content of the progress file of an import
+type importProgress struct {
+	// SHA256 is the digest of the imported file, a progress file is only
+	// resumed for the same content
+	SHA256 string `json:"sha256"`
+	// Minted is the number of rows of the file minted so far
+	Minted int `json:"minted"`
+	// InFlight is set while the batch following the minted rows is
+	// broadcast, the transaction may be included even if the import stops
+	InFlight bool `json:"in_flight,omitempty"`
+}
+
+// loadImportProgress returns the progress of the import of the file recorded
+// in the progress file, or a new progress when it doesn't exist
+func loadImportProgress(progressFile, file string) (importProgress, error) {
+	bz, err := ioutil.ReadFile(file)
+	if err != nil {
+		return importProgress{}, err
+	}
+	digest := sha256.Sum256(bz)
+	progress := importProgress{SHA256: hex.EncodeToString(digest[:])}
+
+	bz, err = ioutil.ReadFile(progressFile)
+	if os.IsNotExist(err) {
+		return progress, nil
+	}
+	if err != nil {
+		return importProgress{}, err
+	}
+
+	var recorded importProgress
+	if err := json.Unmarshal(bz, &recorded); err != nil {
+		return importProgress{}, fmt.Errorf("failed to decode the progress file %s: %w", progressFile, err)
+	}
+	if recorded.SHA256 != progress.SHA256 {
+		return importProgress{}, fmt.Errorf("the progress file %s records the import of another content than %s, remove it to start over", progressFile, file)
+	}
+	return recorded, nil
+}
+
+// save writes the progress file through a temporary file, so that an
+// interrupted write leaves the previous progress
+func (p importProgress) save(progressFile string) error {
+	bz, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(progressFile+".tmp", bz, 0600); err != nil {
+		return err
+	}
+	return os.Rename(progressFile+".tmp", progressFile)
+}
+
+// collectionImporter mints rows in batches and records its progress
+type collectionImporter struct {
+	batchSize int
+	// exists returns whether the NFT of the row is already minted
+	exists func(row nftRow) (bool, error)
+	// mint mints the rows in a single transaction
+	mint func(batch []nftRow) error
+	out  io.Writer
+}
+
+// run mints the rows following the ones recorded by the progress, saving the
+// progress before and after every batch. The batch is recorded as in flight
+// before it is broadcast, the rows of an in flight batch that are already
+// minted are skipped when resuming, as its transaction may have been included
+// anyway.
+func (im collectionImporter) run(rows []nftRow, progress importProgress, progressFile string) error {
+	if im.batchSize <= 0 {
+		return fmt.Errorf("invalid batch size %d", im.batchSize)
+	}
+	if progress.Minted > len(rows) {
+		return fmt.Errorf("the progress file %s records %d minted rows out of %d", progressFile, progress.Minted, len(rows))
+	}
+	if progress.Minted > 0 || progress.InFlight {
+		fmt.Fprintf(im.out, "resuming the import after %d of %d rows\n", progress.Minted, len(rows))
+	}
+
+	for progress.Minted < len(rows) {
+		end := progress.Minted + im.batchSize
+		if end > len(rows) {
+			end = len(rows)
+		}
+
+		batch := make([]nftRow, 0, end-progress.Minted)
+		for _, row := range rows[progress.Minted:end] {
+			if progress.InFlight {
+				exists, err := im.exists(row)
+				if err != nil {
+					return err
+				}
+				if exists {
+					continue
+				}
+			}
+			batch = append(batch, row)
+		}
+
+		if len(batch) > 0 {
+			progress.InFlight = true
+			if err := progress.save(progressFile); err != nil {
+				return err
+			}
+			if err := im.mint(batch); err != nil {
+				return fmt.Errorf("failed to mint the rows %d to %d, run the command again to resume: %w", progress.Minted+1, end, err)
+			}
+		}
+
+		progress.Minted, progress.InFlight = end, false
+		if err := progress.save(progressFile); err != nil {
+			return err
+		}
+		fmt.Fprintf(im.out, "minted %d of %d rows\n", progress.Minted, len(rows))
+	}
+	return nil
+}
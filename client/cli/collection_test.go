@@ -0,0 +1,155 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/irismod/nft/types"
+)
+
+var testRows = []nftRow{
+	{DenomID: "kitty", TokenID: "kitty1", Owner: sdk.AccAddress("alice_______________").String(), Name: "Tom", URI: "ipfs://tom",
+		Attributes: []types.Attribute{types.NewAttribute("rarity", "legendary", ""), types.NewAttribute("lives", "9", types.AttributeTypeNumber)}},
+	{DenomID: "kitty", TokenID: "kitty2", Owner: sdk.AccAddress("bob_________________").String(), Data: `{"color":"grey, white"}`},
+	{DenomID: "kitty", TokenID: "kitty3"},
+}
+
+func TestNFTRowsRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	for _, format := range []string{"json", "csv"} {
+		t.Run(format, func(t *testing.T) {
+			var buf bytes.Buffer
+			writer, err := newNFTRowWriter(&buf, format)
+			require.NoError(t, err)
+			for _, row := range testRows {
+				require.NoError(t, writer.Write(row))
+			}
+			require.NoError(t, writer.Close())
+
+			path := filepath.Join(dir, "collection."+format)
+			require.NoError(t, ioutil.WriteFile(path, buf.Bytes(), 0600))
+			rows, err := readNFTRows(path)
+			require.NoError(t, err)
+			require.Equal(t, testRows, rows)
+		})
+	}
+
+	_, err := newNFTRowWriter(ioutil.Discard, "xml")
+	require.Error(t, err)
+}
+
+// pagedQueryClient serves the Collection query of the NFTs page by page, the
+// other methods are left to the nil embedded interface
+type pagedQueryClient struct {
+	types.QueryClient
+
+	nfts []types.BaseNFT
+}
+
+func (c pagedQueryClient) Collection(_ context.Context, req *types.QueryCollectionRequest, _ ...grpc.CallOption) (*types.QueryCollectionResponse, error) {
+	if !req.RawURIs {
+		return nil, errors.New("the export must query the stored uris")
+	}
+	start := 0
+	if len(req.Pagination.Key) > 0 {
+		start = int(req.Pagination.Key[0])
+	}
+	end := start + int(req.Pagination.Limit)
+	res := &types.QueryCollectionResponse{Pagination: &query.PageResponse{}}
+	if end < len(c.nfts) {
+		res.Pagination.NextKey = []byte{byte(end)}
+	} else {
+		end = len(c.nfts)
+	}
+	res.Collection = &types.Collection{NFTs: c.nfts[start:end]}
+	return res, nil
+}
+
+func TestExportCollection(t *testing.T) {
+	client := pagedQueryClient{}
+	for _, id := range []string{"a1", "a2", "a3", "a4", "a5"} {
+		client.nfts = append(client.nfts, types.BaseNFT{Id: id})
+	}
+
+	var ids []string
+	require.NoError(t, exportCollection(context.Background(), client, "kitty", 2, func(nft types.BaseNFT) error {
+		ids = append(ids, nft.Id)
+		return nil
+	}))
+	require.Equal(t, []string{"a1", "a2", "a3", "a4", "a5"}, ids)
+}
+
+func TestImportCollection(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "collection.json")
+	require.NoError(t, ioutil.WriteFile(file, []byte("[]"), 0600))
+	progressFile := file + ".progress"
+
+	minted := make(map[string]bool)
+	var failAt string
+	importer := collectionImporter{
+		batchSize: 2,
+		exists:    func(row nftRow) (bool, error) { return minted[row.TokenID], nil },
+		mint: func(batch []nftRow) error {
+			for _, row := range batch {
+				if row.TokenID == failAt {
+					return errors.New("out of gas")
+				}
+				require.False(t, minted[row.TokenID], "%s minted twice", row.TokenID)
+			}
+			for _, row := range batch {
+				minted[row.TokenID] = true
+			}
+			return nil
+		},
+		out: ioutil.Discard,
+	}
+
+	rows := []nftRow{{TokenID: "kitty1"}, {TokenID: "kitty2"}, {TokenID: "kitty3"}, {TokenID: "kitty4"}, {TokenID: "kitty5"}}
+
+	// the first batch fails although its transaction is included
+	failAt = "kitty1"
+	progress, err := loadImportProgress(progressFile, file)
+	require.NoError(t, err)
+	require.Error(t, importer.run(rows, progress, progressFile))
+	minted["kitty1"], minted["kitty2"] = true, true
+
+	progress, err = loadImportProgress(progressFile, file)
+	require.NoError(t, err)
+	require.Equal(t, importProgress{SHA256: progress.SHA256, Minted: 0, InFlight: true}, progress)
+
+	failAt = "kitty3"
+	require.Error(t, importer.run(rows, progress, progressFile))
+	require.Equal(t, map[string]bool{"kitty1": true, "kitty2": true}, minted)
+
+	// the transaction of the failed batch was included after all
+	minted["kitty3"], minted["kitty4"] = true, true
+	failAt = ""
+
+	progress, err = loadImportProgress(progressFile, file)
+	require.NoError(t, err)
+	require.Equal(t, 2, progress.Minted)
+	require.True(t, progress.InFlight)
+	require.NoError(t, importer.run(rows, progress, progressFile))
+	require.Len(t, minted, 5)
+
+	progress, err = loadImportProgress(progressFile, file)
+	require.NoError(t, err)
+	require.Equal(t, 5, progress.Minted)
+	require.False(t, progress.InFlight)
+
+	// the progress belongs to the content of the file
+	require.NoError(t, ioutil.WriteFile(file, []byte("[ ]"), 0600))
+	_, err = loadImportProgress(progressFile, file)
+	require.Error(t, err)
+}
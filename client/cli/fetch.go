@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// fetchURIContent returns the content addressed by the uri. file:// and data:
+// uris, as well as plain paths, are resolved locally without any network access.
+func fetchURIContent(uri, ipfsGateway string, timeout time.Duration) ([]byte, error) {
+	uri = strings.TrimSpace(uri)
+	if len(uri) == 0 {
+		return nil, fmt.Errorf("empty uri")
+	}
+
+	if strings.HasPrefix(uri, "data:") {
+		return decodeDataURI(uri)
+	}
+
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, fmt.Errorf("invalid uri %s: %w", uri, err)
+	}
+
+	switch strings.ToLower(u.Scheme) {
+	case "":
+		return ioutil.ReadFile(uri)
+	case "file":
+		path := u.Path
+		if len(u.Host) > 0 && u.Host != "localhost" {
+			path = u.Host + path
+		}
+		return ioutil.ReadFile(path)
+	case "ipfs":
+		// both ipfs://<cid> and ipfs:<cid> are accepted
+		path := strings.TrimPrefix(uri[len(u.Scheme)+1:], "//")
+		path = strings.TrimPrefix(path, "ipfs/")
+		if len(path) == 0 {
+			return nil, fmt.Errorf("invalid uri %s: missing ipfs path", uri)
+		}
+		gateway := strings.TrimSuffix(ipfsGateway, "/") + "/"
+		return fetchHTTP(gateway+path, timeout)
+	case "http", "https":
+		return fetchHTTP(uri, timeout)
+	default:
+		return nil, fmt.Errorf("unsupported uri scheme %s", u.Scheme)
+	}
+}
+
+// decodeDataURI decodes a RFC 2397 data uri: data:[<mediatype>][;base64],<data>
+func decodeDataURI(uri string) ([]byte, error) {
+	sep := strings.Index(uri, ",")
+	if sep < 0 {
+		return nil, fmt.Errorf("invalid data uri, missing ','")
+	}
+
+	header, payload := uri[len("data:"):sep], uri[sep+1:]
+	if strings.HasSuffix(header, ";base64") {
+		bz, err := base64.StdEncoding.DecodeString(payload)
+		if err != nil {
+			return nil, fmt.Errorf("invalid base64 data uri: %w", err)
+		}
+		return bz, nil
+	}
+
+	data, err := url.PathUnescape(payload)
+	if err != nil {
+		return nil, fmt.Errorf("invalid data uri: %w", err)
+	}
+	return []byte(data), nil
+}
+
+func fetchHTTP(uri string, timeout time.Duration) ([]byte, error) {
+	client := &http.Client{Timeout: timeout}
+	resp, err := client.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch %s: %s", uri, resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeDataURI(t *testing.T) {
+	testCases := []struct {
+		name    string
+		uri     string
+		content string
+		expErr  bool
+	}{
+		{"plain", `data:application/json,{"name":"kitty"}`, `{"name":"kitty"}`, false},
+		{"escaped", "data:text/plain;charset=utf-8,a%20kitty", "a kitty", false},
+		{"base64", "data:application/json;base64,eyJuYW1lIjoia2l0dHkifQ==", `{"name":"kitty"}`, false},
+		{"empty", "data:,", "", false},
+		{"missing comma", "data:application/json", "", true},
+		{"invalid base64", "data:;base64,!!", "", true},
+		{"invalid escape", "data:,%zz", "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := decodeDataURI(tc.uri)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.content, string(bz))
+		})
+	}
+}
+
+func TestFetchURIContent(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "kitty.json")
+	require.NoError(t, ioutil.WriteFile(file, []byte(`{"name":"kitty"}`), 0600))
+
+	// the gateway serves the ipfs paths it receives
+	gateway := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte("content of " + r.URL.Path))
+	}))
+	defer gateway.Close()
+
+	testCases := []struct {
+		name    string
+		uri     string
+		content string
+		expErr  bool
+	}{
+		{"empty", " ", "", true},
+		{"data", `data:application/json,{"name":"kitty"}`, `{"name":"kitty"}`, false},
+		{"plain path", file, `{"name":"kitty"}`, false},
+		{"file", "file://" + file, `{"name":"kitty"}`, false},
+		{"file on localhost", "file://localhost" + file, `{"name":"kitty"}`, false},
+		{"missing file", "file://" + filepath.Join(dir, "missing.json"), "", true},
+		{"ipfs", "ipfs://QmCid/1.json", "content of /ipfs/QmCid/1.json", false},
+		{"ipfs with the ipfs path", "ipfs://ipfs/QmCid", "content of /ipfs/QmCid", false},
+		{"ipfs without slashes", "ipfs:QmCid", "content of /ipfs/QmCid", false},
+		{"short ipfs", "ipfs:a", "content of /ipfs/a", false},
+		{"empty ipfs", "ipfs:", "", true},
+		{"http", gateway.URL + "/kitty.json", "content of /kitty.json", false},
+		{"http error", gateway.URL + "/missing", "", true},
+		{"unsupported scheme", "ftp://host/kitty.json", "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := fetchURIContent(tc.uri, gateway.URL+"/ipfs/", time.Second)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.content, string(bz))
+		})
+	}
+}
@@ -1,19 +1,62 @@
 package cli
 
 import (
+	"time"
+
 	flag "github.com/spf13/pflag"
 )
 
 const (
-	FlagTokenName = "name"
-	FlagTokenURI  = "uri"
-	FlagTokenData = "data"
-	FlagRecipient = "recipient"
-	FlagOwner     = "owner"
+	FlagTokenName       = "name"
+	FlagTokenURI        = "uri"
+	FlagURIHash         = "uri-hash"
+	FlagTokenData       = "data"
+	FlagAttributes      = "attributes"
+	FlagTraitKey        = "key"
+	FlagClearAttributes = "clear-attributes"
+	FlagRecipient       = "recipient"
+	FlagOwner           = "owner"
 
 	FlagDenomName = "name"
 	FlagDenom     = "denom"
 	FlagSchema    = "schema"
+	FlagBaseURI   = "base-uri"
+
+	FlagHistoryRetention = "history-retention"
+	FlagRevocable        = "revocable"
+
+	FlagTokenIDs   = "token-ids"
+	FlagSpendLimit = "spend-limit"
+	FlagExpiration = "expiration"
+
+	FlagAdd    = "add"
+	FlagRemove = "remove"
+
+	FlagFile         = "file"
+	FlagIPFSGateway  = "ipfs-gateway"
+	FlagFetchTimeout = "fetch-timeout"
+
+	FlagFromHeight = "from-height"
+	FlagEventTypes = "event-types"
+
+	FlagFormat       = "format"
+	FlagPageLimit    = "page-limit"
+	FlagBatchSize    = "batch-size"
+	FlagProgressFile = "progress-file"
+	FlagMinTokens    = "min-tokens"
+	FlagExclude      = "exclude"
+
+	FlagIDPrefix     = "id-prefix"
+	FlagAddressMap   = "address-map"
+	FlagClaimAddress = "claim-address"
+	FlagReport       = "report"
+
+	FlagTransferPolicies = "transfer-policies"
+
+	FlagDescription  = "description"
+	FlagImage        = "image"
+	FlagExternalURL  = "external-url"
+	FlagAnimationURL = "animation-url"
 )
 
 var (
@@ -23,26 +66,112 @@ var (
 	FsTransferNFT = flag.NewFlagSet("", flag.ContinueOnError)
 	FsQuerySupply = flag.NewFlagSet("", flag.ContinueOnError)
 	FsQueryOwner  = flag.NewFlagSet("", flag.ContinueOnError)
+	FsVerifyURI   = flag.NewFlagSet("", flag.ContinueOnError)
+	FsVerifyProof = flag.NewFlagSet("", flag.ContinueOnError)
+	FsGrant       = flag.NewFlagSet("", flag.ContinueOnError)
+	FsPolicyList  = flag.NewFlagSet("", flag.ContinueOnError)
+	FsRevokeNFT   = flag.NewFlagSet("", flag.ContinueOnError)
+	FsWatch       = flag.NewFlagSet("", flag.ContinueOnError)
+
+	FsMetadataTemplate = flag.NewFlagSet("", flag.ContinueOnError)
+
+	FsAddGenesisNFT        = flag.NewFlagSet("", flag.ContinueOnError)
+	FsAddGenesisCollection = flag.NewFlagSet("", flag.ContinueOnError)
+	FsValidateGenesis      = flag.NewFlagSet("", flag.ContinueOnError)
+
+	FsExportCollection = flag.NewFlagSet("", flag.ContinueOnError)
+	FsImportCollection = flag.NewFlagSet("", flag.ContinueOnError)
+	FsSnapshot         = flag.NewFlagSet("", flag.ContinueOnError)
+
+	FsQueryTraitHistogram = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
 	FsIssueDenom.String(FlagSchema, "", "Denom data structure definition")
 	FsIssueDenom.String(FlagDenomName, "", "The name of the denom")
+	FsIssueDenom.String(FlagTokenURI, "", "URI for supplemental off-chain metadata of the denom")
+	FsIssueDenom.String(FlagURIHash, "", "Hex encoded sha256 digest or multihash of the content behind the uri")
+	FsIssueDenom.String(FlagBaseURI, "", "Base URI the empty and relative token uris are resolved against, e.g. ipfs://<cid>/ or ipfs://<cid>/{id}.json")
+	FsIssueDenom.Uint64(FlagHistoryRetention, 0, "Number of ownership history entries kept per token, 0 uses the default")
+	FsIssueDenom.Bool(FlagRevocable, false, "Allow the creator to revoke the tokens of the denom held by others")
 
 	FsMintNFT.String(FlagTokenURI, "", "URI for supplemental off-chain tokenData (should return a JSON object)")
+	FsMintNFT.String(FlagURIHash, "", "Hex encoded sha256 digest or multihash of the content behind the uri")
 	FsMintNFT.String(FlagRecipient, "", "Receiver of the nft, if not filled, the default is the sender of the transaction")
 	FsMintNFT.String(FlagTokenData, "", "The origin data of nft")
 	FsMintNFT.String(FlagTokenName, "", "The name of nft")
+	FsMintNFT.String(FlagAttributes, "", `The attributes of nft as a JSON array, e.g. [{"key":"rarity","value":"legendary"}]`)
 
 	FsEditNFT.String(FlagTokenURI, "[do-not-modify]", "URI for supplemental off-chain tokenData (should return a JSON object)")
+	FsEditNFT.String(FlagURIHash, "[do-not-modify]", "Hex encoded sha256 digest or multihash of the content behind the uri")
 	FsEditNFT.String(FlagTokenData, "[do-not-modify]", "The tokenData of nft")
 	FsEditNFT.String(FlagTokenName, "[do-not-modify]", "The name of nft")
+	FsEditNFT.String(FlagAttributes, "[do-not-modify]", `The attributes of nft as a JSON array, e.g. [{"key":"rarity","value":"legendary"}]`)
+	FsEditNFT.Bool(FlagClearAttributes, false, "Remove every attribute of the nft")
 
 	FsTransferNFT.String(FlagTokenURI, "[do-not-modify]", "URI for supplemental off-chain tokenData (should return a JSON object)")
+	FsTransferNFT.String(FlagURIHash, "[do-not-modify]", "Hex encoded sha256 digest or multihash of the content behind the uri")
 	FsTransferNFT.String(FlagTokenData, "[do-not-modify]", "The tokenData of nft")
 	FsTransferNFT.String(FlagTokenName, "[do-not-modify]", "The name of nft")
 
 	FsQuerySupply.String(FlagOwner, "", "The owner of a nft")
 
 	FsQueryOwner.String(FlagDenom, "", "The name of a collection")
+
+	FsQueryTraitHistogram.String(FlagTraitKey, "", "Only count the values of the given trait key")
+
+	FsGrant.StringSlice(FlagTokenIDs, nil, "Restrict the transfer, edit or burn grant to these token ids, every token of the denom if empty")
+	FsGrant.Uint64(FlagSpendLimit, 0, "Maximum number of tokens the grantee may mint")
+	FsGrant.String(FlagExpiration, "", "Expiration of the grant in RFC3339 format, one year from now if empty")
+
+	FsRevokeNFT.String(FlagRecipient, "", "Receiver of the reclaimed nft, the nft is burned if not filled")
+
+	FsPolicyList.StringSlice(FlagAdd, nil, "Addresses to add to the list")
+	FsPolicyList.StringSlice(FlagRemove, nil, "Addresses to remove from the list")
+
+	FsVerifyURI.String(FlagTokenURI, "", "Location of the content, overrides the uri stored on chain")
+	FsVerifyURI.String(FlagURIHash, "", "Expected uri hash, skips querying the chain when used together with --uri or --file")
+	FsVerifyURI.String(FlagFile, "", "Local file holding the content to verify")
+	FsVerifyURI.String(FlagIPFSGateway, "https://ipfs.io/ipfs/", "HTTP gateway used to fetch ipfs:// uris")
+	FsVerifyURI.Duration(FlagFetchTimeout, 30*time.Second, "Timeout for fetching remote content")
+
+	FsVerifyProof.String(FlagOwner, "", "Also check that the nft is owned by the address")
+
+	FsWatch.Int64(FlagFromHeight, 0, "First height to stream the events from, 0 starts at the next block")
+	FsWatch.StringSlice(FlagEventTypes, nil, "Only stream these event types, e.g. transfer_nft,mint_nft")
+
+	FsMetadataTemplate.String(FlagDescription, "", "JSON pointer into the token data of the description, e.g. /description")
+	FsMetadataTemplate.String(FlagImage, "", "JSON pointer into the token data of the image, the token uri is used if missing")
+	FsMetadataTemplate.String(FlagExternalURL, "", "JSON pointer into the token data of the external url")
+	FsMetadataTemplate.String(FlagAnimationURL, "", "JSON pointer into the token data of the animation url")
+	FsMetadataTemplate.String(FlagAttributes, "", "JSON pointer into the token data of the attributes, either an array of ERC-721 attributes or an object")
+
+	FsAddGenesisNFT.String(FlagTokenURI, "", "URI for supplemental off-chain tokenData (should return a JSON object)")
+	FsAddGenesisNFT.String(FlagURIHash, "", "Hex encoded sha256 digest or multihash of the content behind the uri")
+	FsAddGenesisNFT.String(FlagTokenData, "", "The origin data of nft")
+	FsAddGenesisNFT.String(FlagTokenName, "", "The name of nft")
+	FsAddGenesisNFT.String(FlagAttributes, "", `The attributes of nft as a JSON array, e.g. [{"key":"rarity","value":"legendary"}]`)
+
+	FsAddGenesisCollection.String(FlagDenomName, "", "The name of the denom, the name of the CW721 contract by default")
+	FsAddGenesisCollection.String(FlagSchema, "", "Denom data structure definition")
+	FsAddGenesisCollection.String(FlagBaseURI, "", "Base URI the empty and relative token uris are resolved against, e.g. ipfs://<cid>/")
+	FsAddGenesisCollection.String(FlagIDPrefix, "", "Prefix of the mapped token ids, the denom id by default")
+	FsAddGenesisCollection.String(FlagAddressMap, "", "JSON or CSV file mapping the owners to local addresses or key names, with the columns original,address")
+	FsAddGenesisCollection.String(FlagClaimAddress, "", "Address or key name receiving the NFTs of the owners that can't be converted")
+	FsAddGenesisCollection.String(FlagReport, "", "File the JSON report of the mapped ids and owners is written to, the standard output by default")
+
+	FsValidateGenesis.StringSlice(FlagTransferPolicies, nil, "Names of the custom transfer policies registered by the application")
+
+	FsExportCollection.String(FlagFormat, "json", "Output format, json or csv")
+	FsExportCollection.Uint64(FlagPageLimit, 100, "Number of NFTs queried per page")
+
+	FsImportCollection.String(FlagDenom, "", "Mint the NFTs in this denom instead of the denom_id of the rows")
+	FsImportCollection.Int(FlagBatchSize, 20, "Number of NFTs minted per transaction")
+	FsImportCollection.String(FlagProgressFile, "", "File recording the progress of the import, [file].progress by default")
+
+	FsSnapshot.String(FlagFormat, "json", "Output format, json or csv")
+	FsSnapshot.Uint64(FlagPageLimit, 1000, "Number of NFTs queried per page")
+	FsSnapshot.Int(FlagMinTokens, 1, "Only list the holders of at least this number of NFTs")
+	FsSnapshot.StringSlice(FlagExclude, nil, "Addresses left out of the snapshot, e.g. the treasury or a marketplace escrow")
+
 }
@@ -0,0 +1,443 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/irismod/nft/client/convert"
+	"github.com/irismod/nft/types"
+)
+
+// GetCmdValidateGenesis validates the nft section of a genesis file offline,
+// the application registers it next to the add-genesis commands
+func GetCmdValidateGenesis() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate-nft-genesis [genesis-file]",
+		Short: "Validate the nft section of genesis.json",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Validate the nft section of a genesis file and list every problem found,
+without checking the sections of the other modules. The transfer policies of the denoms must be
+built-in ones or be listed by --transfer-policies, the custom policies registered by the application.
+Example:
+$ %s validate-nft-genesis ~/.nftd/config/genesis.json --transfer-policies=<policy>`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			appState, _, err := genutiltypes.GenesisStateFromGenFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			section, ok := appState[types.ModuleName]
+			if !ok {
+				return fmt.Errorf("genesis file %s has no %s section", args[0], types.ModuleName)
+			}
+
+			var data types.GenesisState
+			if err := clientCtx.JSONMarshaler.UnmarshalJSON(section, &data); err != nil {
+				return fmt.Errorf("failed to decode the %s section of %s: %w", types.ModuleName, args[0], err)
+			}
+
+			if err := types.ValidateGenesis(data); err != nil {
+				return err
+			}
+
+			custom, _ := cmd.Flags().GetStringSlice(FlagTransferPolicies)
+			registered := func(name string) bool {
+				for _, policy := range custom {
+					if policy == name {
+						return true
+					}
+				}
+				return types.IsBuiltinPolicy(name)
+			}
+			if err := types.ValidateGenesisPolicies(data, registered); err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "the %s section of %s is valid\n", types.ModuleName, args[0])
+			return err
+		},
+	}
+	cmd.Flags().AddFlagSet(FsValidateGenesis)
+
+	return cmd
+}
+
+// GetCmdAddGenesisDenom adds denoms to the nft section of the genesis file,
+// the application registers it next to add-genesis-account
+func GetCmdAddGenesisDenom(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-genesis-denom [denomID] [creator_address_or_key_name]",
+		Short: "Add a denom to genesis.json",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Add a denom to the nft section of genesis.json, or every denom of a JSON or CSV file
+with --file. A JSON file holds an array of denoms, the header of a CSV file names the columns among
+%s. The creators are addresses or names of keys of the keyring.
+The genesis file is only written if the resulting nft section is valid.
+Example:
+$ %s add-genesis-denom kitty <creator> --name=Kitties --schema=<schema> --base-uri=ipfs://<cid>/
+$ %s add-genesis-denom --file=denoms.csv`,
+				strings.Join(denomColumns, ","), version.AppName, version.AppName)),
+		Args: genesisArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var rows []denomRow
+			file, _ := cmd.Flags().GetString(FlagFile)
+			if len(file) > 0 {
+				var err error
+				if rows, err = readDenomRows(file); err != nil {
+					return err
+				}
+			} else {
+				row := denomRow{ID: args[0], Creator: args[1]}
+				row.Name, _ = cmd.Flags().GetString(FlagDenomName)
+				row.Schema, _ = cmd.Flags().GetString(FlagSchema)
+				row.URI, _ = cmd.Flags().GetString(FlagTokenURI)
+				row.URIHash, _ = cmd.Flags().GetString(FlagURIHash)
+				row.BaseURI, _ = cmd.Flags().GetString(FlagBaseURI)
+				row.HistoryRetention, _ = cmd.Flags().GetUint64(FlagHistoryRetention)
+				row.Revocable, _ = cmd.Flags().GetBool(FlagRevocable)
+				rows = append(rows, row)
+			}
+
+			resolver := newAddressResolver(cmd)
+			return updateGenesis(cmd, func(data *types.GenesisState) error {
+				for i, row := range rows {
+					creator, err := resolver.resolve(row.Creator)
+					if err != nil {
+						return fmt.Errorf("denoms[%d] (denom %q): %w", i, row.ID, err)
+					}
+
+					msg := types.NewMsgIssueDenom(row.ID, row.Name, row.Schema, row.URI, row.URIHash, row.BaseURI,
+						row.HistoryRetention, row.Revocable, creator.String())
+					if err := msg.ValidateBasic(); err != nil {
+						return fmt.Errorf("denoms[%d] (denom %q): %w", i, row.ID, err)
+					}
+
+					denom := types.NewDenom(msg.Id, msg.Name, msg.Schema, msg.URI, msg.URIHash, msg.BaseURI,
+						msg.HistoryRetention, msg.Revocable, creator)
+					data.Collections = append(data.Collections, types.NewCollection(denom, nil))
+				}
+				return nil
+			})
+		},
+	}
+	cmd.Flags().AddFlagSet(FsIssueDenom)
+	addGenesisFlags(cmd, defaultNodeHome)
+
+	return cmd
+}
+
+// GetCmdAddGenesisNFT adds NFTs to the collections of the nft section of the
+// genesis file, the application registers it next to add-genesis-account
+func GetCmdAddGenesisNFT(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-genesis-nft [denomID] [tokenID] [owner_address_or_key_name]",
+		Short: "Add a nft to genesis.json",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Add a nft to a denom of the nft section of genesis.json, or every nft of a JSON or CSV
+file with --file. A JSON file holds an array of nfts, the header of a CSV file names the columns among
+%s, the attributes being a JSON array. The owners are addresses or names of keys of the keyring.
+The denoms must have been added first. The genesis file is only written if the resulting nft section is valid.
+Example:
+$ %s add-genesis-nft kitty kitty1 <owner> --name=Tom --uri=ipfs://<cid> --attributes='[{"key":"rarity","value":"legendary"}]'
+$ %s add-genesis-nft --file=nfts.csv`,
+				strings.Join(nftColumns, ","), version.AppName, version.AppName)),
+		Args: genesisArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var rows []nftRow
+			file, _ := cmd.Flags().GetString(FlagFile)
+			if len(file) > 0 {
+				var err error
+				if rows, err = readNFTRows(file); err != nil {
+					return err
+				}
+			} else {
+				row := nftRow{DenomID: args[0], TokenID: args[1], Owner: args[2]}
+				row.Name, _ = cmd.Flags().GetString(FlagTokenName)
+				row.URI, _ = cmd.Flags().GetString(FlagTokenURI)
+				row.URIHash, _ = cmd.Flags().GetString(FlagURIHash)
+				row.Data, _ = cmd.Flags().GetString(FlagTokenData)
+				attributes, _ := cmd.Flags().GetString(FlagAttributes)
+				var err error
+				if row.Attributes, err = parseAttributes(attributes); err != nil {
+					return err
+				}
+				rows = append(rows, row)
+			}
+
+			resolver := newAddressResolver(cmd)
+			return updateGenesis(cmd, func(data *types.GenesisState) error {
+				collections := make(map[string]int, len(data.Collections))
+				for i, c := range data.Collections {
+					collections[c.Denom.Id] = i
+				}
+
+				for i, row := range rows {
+					owner, err := resolver.resolve(row.Owner)
+					if err != nil {
+						return fmt.Errorf("nfts[%d] (denom %q, token %q): %w", i, row.DenomID, row.TokenID, err)
+					}
+
+					msg := types.NewMsgMintNFT(row.TokenID, strings.ToLower(row.DenomID), row.Name, row.URI, row.URIHash, row.Data,
+						row.Attributes, owner.String(), owner.String())
+					if err := msg.ValidateBasic(); err != nil {
+						return fmt.Errorf("nfts[%d] (denom %q, token %q): %w", i, row.DenomID, row.TokenID, err)
+					}
+
+					j, ok := collections[msg.Denom]
+					if !ok {
+						return fmt.Errorf("nfts[%d] (denom %q, token %q): %w", i, row.DenomID, row.TokenID, types.ErrUnknownCollection)
+					}
+					nft := types.NewBaseNFT(msg.Id, msg.Name, owner, msg.URI, msg.URIHash, msg.Data, msg.Attributes)
+					data.Collections[j] = data.Collections[j].AddNFT(nft)
+				}
+				return nil
+			})
+		},
+	}
+	cmd.Flags().AddFlagSet(FsAddGenesisNFT)
+	addGenesisFlags(cmd, defaultNodeHome)
+
+	return cmd
+}
+
+// GetCmdAddGenesisCollection converts a CW721 export or an ERC-721 holder list
+// into a collection of the nft section of the genesis file, the application
+// registers it next to add-genesis-account
+func GetCmdAddGenesisCollection(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-genesis-collection [cw721|erc721] [file] [denomID] [creator_address_or_key_name]",
+		Short: "Add a collection of another chain to genesis.json",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Convert the collection of another chain into a denom of the nft section of genesis.json.
+A cw721 file is the JSON export of a CW721 contract, with its contract_info and its tokens, an erc721 file
+is a CSV holder list naming the token id, owner and optionally token uri columns.
+The token ids rejected by the module are mapped to the lowercased id, to --id-prefix followed by the
+alphanumeric characters of the id, or to --id-prefix followed by a digest of the id, the original id being
+added to the data of the NFT under "original_token_id".
+The owners are converted through --address-map, a JSON or CSV file with the columns original,address,
+then as bech32 addresses of any prefix. The NFTs of the other owners go to --claim-address, or fail the
+conversion when it isn't set.
+The report of the mapped ids and owners is written to --report, or to the standard output. The genesis
+file is only written if the resulting nft section is valid.
+Example:
+$ %s add-genesis-collection cw721 export.json kitty <creator> --address-map=owners.csv --claim-address=<address> --report=report.json`,
+				version.AppName)),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			f, err := os.Open(args[1])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			var src convert.Source
+			switch strings.ToLower(args[0]) {
+			case "cw721":
+				src, err = convert.ReadCW721(f)
+			case "erc721":
+				src, err = convert.ReadERC721(f)
+			default:
+				return fmt.Errorf("unsupported source %s, expected cw721 or erc721", args[0])
+			}
+			if err != nil {
+				return fmt.Errorf("%s: %w", args[1], err)
+			}
+
+			resolver := newAddressResolver(cmd)
+			cfg := convert.Config{DenomID: args[2]}
+			if cfg.Creator, err = resolver.resolve(args[3]); err != nil {
+				return err
+			}
+			cfg.DenomName, _ = cmd.Flags().GetString(FlagDenomName)
+			cfg.Schema, _ = cmd.Flags().GetString(FlagSchema)
+			cfg.BaseURI, _ = cmd.Flags().GetString(FlagBaseURI)
+			cfg.IDPrefix, _ = cmd.Flags().GetString(FlagIDPrefix)
+			if claim, _ := cmd.Flags().GetString(FlagClaimAddress); len(claim) > 0 {
+				if cfg.ClaimAddress, err = resolver.resolve(claim); err != nil {
+					return err
+				}
+			}
+			if file, _ := cmd.Flags().GetString(FlagAddressMap); len(file) > 0 {
+				if cfg.AddressMap, err = readAddressMap(file, resolver); err != nil {
+					return err
+				}
+			}
+
+			collection, report, err := convert.Convert(src, cfg)
+			if err != nil {
+				return err
+			}
+			if err := updateGenesis(cmd, func(data *types.GenesisState) error {
+				data.Collections = append(data.Collections, collection)
+				return nil
+			}); err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				return err
+			}
+			if file, _ := cmd.Flags().GetString(FlagReport); len(file) > 0 {
+				return ioutil.WriteFile(file, append(bz, '\n'), 0644)
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			return err
+		},
+	}
+	cmd.Flags().AddFlagSet(FsAddGenesisCollection)
+	addGenesisHomeFlags(cmd, defaultNodeHome)
+
+	return cmd
+}
+
+// readAddressMap reads the foreign owners and their local addresses or key
+// names from a JSON or CSV file, the 0x addresses being lowercased
+func readAddressMap(path string, resolver *addressResolver) (map[string]sdk.AccAddress, error) {
+	var rows []addressMapRow
+	err := readRows(path, &rows, addressMapColumns, func(record map[string]string) error {
+		rows = append(rows, addressMapRow{Original: record["original"], Address: record["address"]})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make(map[string]sdk.AccAddress, len(rows))
+	for i, row := range rows {
+		original := strings.TrimSpace(row.Original)
+		if strings.HasPrefix(original, "0x") || strings.HasPrefix(original, "0X") {
+			original = strings.ToLower(original)
+		}
+		if addresses[original], err = resolver.resolve(row.Address); err != nil {
+			return nil, fmt.Errorf("%s entry %d (owner %q): %w", path, i+1, row.Original, err)
+		}
+	}
+	return addresses, nil
+}
+
+// addGenesisFlags adds the flags shared by the add-genesis commands, they are
+// not part of a flag set so that each command gets its own values
+func addGenesisFlags(cmd *cobra.Command, defaultNodeHome string) {
+	cmd.Flags().String(FlagFile, "", "JSON or CSV file of the entries to add instead of the arguments")
+	addGenesisHomeFlags(cmd, defaultNodeHome)
+}
+
+// addGenesisHomeFlags adds the flags locating the genesis file and the keyring
+func addGenesisHomeFlags(cmd *cobra.Command, defaultNodeHome string) {
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
+}
+
+// genesisArgs accepts the n arguments of a single entry, or none with --file
+func genesisArgs(n int) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if file, _ := cmd.Flags().GetString(FlagFile); len(file) > 0 {
+			return cobra.NoArgs(cmd, args)
+		}
+		return cobra.ExactArgs(n)(cmd, args)
+	}
+}
+
+// updateGenesis applies update to the nft section of the genesis file of the
+// home directory and writes it back once validated by types.ValidateGenesis
+func updateGenesis(cmd *cobra.Command, update func(data *types.GenesisState) error) error {
+	clientCtx := client.GetClientContextFromCmd(cmd)
+	config := server.GetServerContextFromCmd(cmd).Config
+	config.SetRoot(homeDir(cmd))
+
+	genFile := config.GenesisFile()
+	appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+	}
+
+	var data types.GenesisState
+	if section, ok := appState[types.ModuleName]; ok {
+		if err := clientCtx.JSONMarshaler.UnmarshalJSON(section, &data); err != nil {
+			return fmt.Errorf("failed to decode the %s section of %s: %w", types.ModuleName, genFile, err)
+		}
+	} else {
+		data = *types.NewGenesisState(types.DefaultParams(), nil, nil, nil, nil, nil, nil, nil, nil)
+	}
+
+	if err := update(&data); err != nil {
+		return err
+	}
+	if err := types.ValidateGenesis(data); err != nil {
+		return err
+	}
+
+	if appState[types.ModuleName], err = clientCtx.JSONMarshaler.MarshalJSON(&data); err != nil {
+		return fmt.Errorf("failed to marshal %s genesis state: %w", types.ModuleName, err)
+	}
+
+	appStateJSON, err := json.Marshal(appState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal application genesis state: %w", err)
+	}
+
+	genDoc.AppState = appStateJSON
+	return genutil.ExportGenesisFile(genDoc, genFile)
+}
+
+// homeDir returns the --home flag when set, the home directory of the client
+// context otherwise
+func homeDir(cmd *cobra.Command) string {
+	clientCtx := client.GetClientContextFromCmd(cmd)
+	if home, _ := cmd.Flags().GetString(flags.FlagHome); len(clientCtx.HomeDir) == 0 || cmd.Flags().Changed(flags.FlagHome) {
+		return home
+	}
+	return clientCtx.HomeDir
+}
+
+// addressResolver resolves bech32 addresses and the names of the keys of the
+// keyring, which is only opened for the first key name
+type addressResolver struct {
+	cmd     *cobra.Command
+	keyring keyring.Keyring
+}
+
+func newAddressResolver(cmd *cobra.Command) *addressResolver {
+	return &addressResolver{cmd: cmd}
+}
+
+func (r *addressResolver) resolve(addressOrName string) (sdk.AccAddress, error) {
+	addressOrName = strings.TrimSpace(addressOrName)
+	if addr, err := sdk.AccAddressFromBech32(addressOrName); err == nil {
+		return addr, nil
+	}
+
+	if r.keyring == nil {
+		keyringBackend, _ := r.cmd.Flags().GetString(flags.FlagKeyringBackend)
+		kr, err := keyring.New(sdk.KeyringServiceName(), keyringBackend, homeDir(r.cmd), bufio.NewReader(r.cmd.InOrStdin()))
+		if err != nil {
+			return nil, err
+		}
+		r.keyring = kr
+	}
+
+	info, err := r.keyring.Key(addressOrName)
+	if err != nil {
+		return nil, fmt.Errorf("%q is neither an address nor a key name: %w", addressOrName, err)
+	}
+	return info.GetAddress(), nil
+}
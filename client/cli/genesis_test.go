@@ -0,0 +1,167 @@
+package cli_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/irismod/nft"
+	simapp "github.com/irismod/nft/app"
+	"github.com/irismod/nft/client/cli"
+	"github.com/irismod/nft/types"
+)
+
+var (
+	alice = sdk.AccAddress("alice_______________")
+	bob   = sdk.AccAddress("bob_________________")
+)
+
+// setupHome writes a genesis file with the default nft section in a new home
+// directory
+func setupHome(t *testing.T) string {
+	home := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0700))
+
+	section, err := simapp.MakeEncodingConfig().Marshaler.MarshalJSON(nft.DefaultGenesisState())
+	require.NoError(t, err)
+	appState, err := json.Marshal(map[string]json.RawMessage{types.ModuleName: section})
+	require.NoError(t, err)
+
+	require.NoError(t, genutil.ExportGenesisFile(
+		&tmtypes.GenesisDoc{ChainID: "test", AppState: appState},
+		filepath.Join(home, "config", "genesis.json"),
+	))
+	return home
+}
+
+// runGenesisCmd runs the command on the genesis file of the home directory
+func runGenesisCmd(t *testing.T, cmd *cobra.Command, home string, args ...string) error {
+	clientCtx := client.Context{}.WithJSONMarshaler(simapp.MakeEncodingConfig().Marshaler).WithHomeDir(home)
+	cmd.SetArgs(args)
+	cmd.SetOut(ioutil.Discard)
+	cmd.SetErr(ioutil.Discard)
+	return cmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx))
+}
+
+func readGenesis(t *testing.T, home string) types.GenesisState {
+	appState, _, err := genutiltypes.GenesisStateFromGenFile(filepath.Join(home, "config", "genesis.json"))
+	require.NoError(t, err)
+
+	var data types.GenesisState
+	require.NoError(t, simapp.MakeEncodingConfig().Marshaler.UnmarshalJSON(appState[types.ModuleName], &data))
+	return data
+}
+
+func writeFile(t *testing.T, dir, name, content string) string {
+	path := filepath.Join(dir, name)
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestAddGenesis(t *testing.T) {
+	home := setupHome(t)
+
+	require.NoError(t, runGenesisCmd(t, cli.GetCmdAddGenesisDenom(home), home, "Kitty", alice.String(), "--name=Kitties", "--base-uri=ipfs://cid/"))
+	require.NoError(t, runGenesisCmd(t, cli.GetCmdAddGenesisNFT(home), home, "kitty", "Kitty1", bob.String(), "--name=Tom",
+		`--attributes=[{"key":"rarity","value":"legendary"}]`))
+
+	denoms := writeFile(t, home, "denoms.csv", "id,name,creator,history_retention,revocable\npunk,Punks,"+alice.String()+",5,true\n")
+	require.NoError(t, runGenesisCmd(t, cli.GetCmdAddGenesisDenom(home), home, "--file="+denoms))
+
+	nfts := writeFile(t, home, "nfts.json", `[
+		{"denom_id":"punk","token_id":"punk1","owner":"`+alice.String()+`","uri":"ipfs://punk1"},
+		{"denom_id":"punk","token_id":"punk2","owner":"`+bob.String()+`","attributes":[{"key":"hat","value":"cap"}]}
+	]`)
+	require.NoError(t, runGenesisCmd(t, cli.GetCmdAddGenesisNFT(home), home, "--file="+nfts))
+
+	data := readGenesis(t, home)
+	require.NoError(t, nft.ValidateGenesis(data))
+	require.Len(t, data.Collections, 2)
+
+	kitty := data.Collections[0]
+	require.Equal(t, "kitty", kitty.Denom.Id)
+	require.Equal(t, "ipfs://cid/", kitty.Denom.BaseURI)
+	require.Equal(t, alice, kitty.Denom.Creator)
+	require.Len(t, kitty.NFTs, 1)
+	require.Equal(t, "kitty1", kitty.NFTs[0].Id)
+	require.Equal(t, bob, kitty.NFTs[0].Owner)
+	require.Equal(t, []types.Attribute{types.NewAttribute("rarity", "legendary", "")}, kitty.NFTs[0].Attributes)
+
+	punk := data.Collections[1]
+	require.Equal(t, uint64(5), punk.Denom.HistoryRetention)
+	require.True(t, punk.Denom.Revocable)
+	require.Len(t, punk.NFTs, 2)
+	require.Equal(t, "ipfs://punk1", punk.NFTs[0].URI)
+	require.Equal(t, bob, punk.NFTs[1].Owner)
+}
+
+func TestAddGenesisInvalid(t *testing.T) {
+	home := setupHome(t)
+	require.NoError(t, runGenesisCmd(t, cli.GetCmdAddGenesisDenom(home), home, "kitty", alice.String()))
+	before := readGenesis(t, home)
+
+	testCases := []struct {
+		name string
+		cmd  *cobra.Command
+		args []string
+	}{
+		{"duplicated denom", cli.GetCmdAddGenesisDenom(home), []string{"kitty", alice.String()}},
+		{"invalid denom", cli.GetCmdAddGenesisDenom(home), []string{"1kitty", alice.String()}},
+		{"unknown denom", cli.GetCmdAddGenesisNFT(home), []string{"punk", "punk1", alice.String()}},
+		{"invalid token", cli.GetCmdAddGenesisNFT(home), []string{"kitty", "k", alice.String()}},
+		{"arguments with a file", cli.GetCmdAddGenesisNFT(home), []string{"kitty", "--file=nfts.csv"}},
+		{"duplicated token in a file", cli.GetCmdAddGenesisNFT(home), []string{"--file=" + writeFile(t, home, "dup.csv",
+			"denom_id,token_id,owner\nkitty,kitty1,"+alice.String()+"\nkitty,kitty1,"+bob.String()+"\n")}},
+		{"unknown column", cli.GetCmdAddGenesisNFT(home), []string{"--file=" + writeFile(t, home, "col.csv",
+			"denom_id,token_id,owner,color\nkitty,kitty1,"+alice.String()+",red\n")}},
+		{"unsupported file", cli.GetCmdAddGenesisNFT(home), []string{"--file=" + writeFile(t, home, "nfts.txt", "")}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Error(t, runGenesisCmd(t, tc.cmd, home, tc.args...))
+			// the genesis file is left untouched
+			require.Equal(t, before, readGenesis(t, home))
+		})
+	}
+}
+
+func TestAddGenesisCollection(t *testing.T) {
+	home := setupHome(t)
+	holders := writeFile(t, home, "holders.csv", "HolderAddress,Token_ID\n0xABC,1\n0xdef,kitty2\n")
+	addressMap := writeFile(t, home, "owners.csv", "original,address\n0xabc,"+bob.String()+"\n")
+	report := filepath.Join(home, "report.json")
+
+	// 0xdef is neither mapped nor claimed
+	require.Error(t, runGenesisCmd(t, cli.GetCmdAddGenesisCollection(home), home, "erc721", holders, "kitty", alice.String(),
+		"--address-map="+addressMap))
+	require.Empty(t, readGenesis(t, home).Collections)
+
+	require.NoError(t, runGenesisCmd(t, cli.GetCmdAddGenesisCollection(home), home, "erc721", holders, "kitty", alice.String(),
+		"--name=Kitties", "--address-map="+addressMap, "--claim-address="+alice.String(), "--report="+report))
+
+	collections := readGenesis(t, home).Collections
+	require.Len(t, collections, 1)
+	require.Equal(t, "Kitties", collections[0].Denom.Name)
+	require.Equal(t, []types.BaseNFT{
+		types.NewBaseNFT("kitty1", "", bob, "", "", `{"original_token_id":"1"}`, []types.Attribute{}),
+		types.NewBaseNFT("kitty2", "", alice, "", "", "", []types.Attribute{}),
+	}, collections[0].NFTs)
+
+	bz, err := ioutil.ReadFile(report)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"token_id": "kitty1"`)
+}
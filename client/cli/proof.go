@@ -0,0 +1,124 @@
+package cli
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/irismod/nft/client/proof"
+	"github.com/irismod/nft/types"
+)
+
+// GetCmdQueryOwnershipProof queries the merkle proof of the owner of a nft
+func GetCmdQueryOwnershipProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "ownership-proof [denomID] [tokenID]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a nft with the merkle proofs of its owner in the state of a height, the latest by default.
+The proof is checked offline with verify-ownership-proof against the app hash of the header of the next block.
+Example:
+$ %s query nft ownership-proof <denom> <tokenID> --height=<height> > proof.json`, version.AppName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom := strings.TrimSpace(args[0])
+			if err := types.ValidateDenomID(denom); err != nil {
+				return err
+			}
+
+			tokenID := strings.TrimSpace(args[1])
+			if err := types.ValidateTokenID(tokenID); err != nil {
+				return err
+			}
+
+			node, err := clientCtx.GetNode()
+			if err != nil {
+				return err
+			}
+
+			p, err := proof.Query(node, denom, tokenID, clientCtx.Height)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(p, "", "  ")
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			return err
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdVerifyOwnershipProof checks an ownership proof against an app hash offline
+func GetCmdVerifyOwnershipProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "verify-ownership-proof [proof-file] [app-hash]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Check a proof returned by ownership-proof against the hex encoded app hash of the header of the block
+following the height of the proof, without querying the chain. The app hash must come from a trusted source.
+The proof is an ABCI store proof checked by the client, the module serves no gRPC endpoint for it.
+Example:
+$ %s query nft verify-ownership-proof proof.json <app-hash> --owner=<address>`, version.AppName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var p proof.OwnershipProof
+			if err := json.Unmarshal(bz, &p); err != nil {
+				return fmt.Errorf("failed to decode the proof %s: %w", args[0], err)
+			}
+
+			appHash, err := hex.DecodeString(strings.TrimSpace(args[1]))
+			if err != nil {
+				return fmt.Errorf("invalid app hash %s: %w", args[1], err)
+			}
+
+			nft, err := p.Verify(appHash)
+			if err != nil {
+				return err
+			}
+
+			owner, err := cmd.Flags().GetString(FlagOwner)
+			if err != nil {
+				return err
+			}
+			if len(owner) > 0 {
+				address, err := sdk.AccAddressFromBech32(owner)
+				if err != nil {
+					return err
+				}
+				if err := p.VerifyOwner(appHash, address); err != nil {
+					return err
+				}
+			}
+
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "%s/%s is owned by %s at height %d\n", p.DenomID, p.TokenID, nft.Owner, p.Height)
+			return err
+		},
+	}
+	cmd.Flags().AddFlagSet(FsVerifyProof)
+
+	return cmd
+}
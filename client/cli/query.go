@@ -31,6 +31,21 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQuerySupply(),
 		GetCmdQueryOwner(),
 		GetCmdQueryNFT(),
+		GetCmdQueryHistory(),
+		GetCmdQueryNFTsByTrait(),
+		GetCmdQueryTraitHistogram(),
+		GetCmdQueryDeposit(),
+		GetCmdQueryPaused(),
+		GetCmdQueryTransferPolicy(),
+		GetCmdQueryMetadata(),
+		GetCmdQueryGrants(),
+		GetCmdQueryParams(),
+		GetCmdVerifyURIHash(),
+		GetCmdQueryOwnershipProof(),
+		GetCmdExportCollection(),
+		GetCmdQuerySnapshot(),
+		GetCmdVerifyOwnershipProof(),
+		GetCmdWatch(),
 	)
 
 	return queryCmd
@@ -265,3 +280,417 @@ $ %s query nft token <denom> <tokenID>`, version.AppName)),
 
 	return cmd
 }
+
+// GetCmdQueryHistory queries the ownership history of a NFT
+func GetCmdQueryHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "history [denomID] [tokenID]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the retained ownership history of a NFT, oldest first
+Example:
+$ %s query nft history <denom> <tokenID>`, version.AppName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom := strings.TrimSpace(args[0])
+			if err := types.ValidateDenomID(denom); err != nil {
+				return err
+			}
+
+			tokenID := strings.TrimSpace(args[1])
+			if err := types.ValidateTokenID(tokenID); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.History(context.Background(), &types.QueryHistoryRequest{
+				Denom:      denom,
+				Id:         tokenID,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintOutput(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "history")
+
+	return cmd
+}
+
+// GetCmdQueryNFTsByTrait queries the NFTs of a collection holding a trait
+func GetCmdQueryNFTsByTrait() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "traits [denomID] [key] [value]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the NFTs of a collection holding the trait key=value
+Example:
+$ %s query nft traits <denom> <key> <value>`, version.AppName)),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom := strings.TrimSpace(args[0])
+			if err := types.ValidateDenomID(denom); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.NFTsByTrait(context.Background(), &types.QueryNFTsByTraitRequest{
+				Denom:      denom,
+				Key:        strings.TrimSpace(args[1]),
+				Value:      strings.TrimSpace(args[2]),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintOutput(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "traits")
+
+	return cmd
+}
+
+// GetCmdQueryTraitHistogram queries the number of NFTs per trait of a collection
+func GetCmdQueryTraitHistogram() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "trait-histogram [denomID]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the number of NFTs per trait of a collection
+Example:
+$ %s query nft trait-histogram <denom> --key=<key>`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom := strings.TrimSpace(args[0])
+			if err := types.ValidateDenomID(denom); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.TraitHistogram(context.Background(), &types.QueryTraitHistogramRequest{
+				Denom:      denom,
+				Key:        strings.TrimSpace(viper.GetString(FlagTraitKey)),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintOutput(resp)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsQueryTraitHistogram)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "trait histogram")
+
+	return cmd
+}
+
+// GetCmdQueryDeposit queries the storage deposit locked for a NFT
+func GetCmdQueryDeposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "deposit [denomID] [tokenID]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the storage deposit locked for a NFT
+Example:
+$ %s query nft deposit <denom> <tokenID>`, version.AppName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom := strings.TrimSpace(args[0])
+			if err := types.ValidateDenomID(denom); err != nil {
+				return err
+			}
+
+			tokenID := strings.TrimSpace(args[1])
+			if err := types.ValidateTokenID(tokenID); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Deposit(context.Background(), &types.QueryDepositRequest{
+				Denom: denom,
+				Id:    tokenID,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintOutput(&resp.Deposit)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPaused queries whether a denom is paused
+func GetCmdQueryPaused() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "paused [denomID]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether the NFTs of a denom are paused by its creator or by governance
+Example:
+$ %s query nft paused <denom>`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom := strings.TrimSpace(args[0])
+			if err := types.ValidateDenomID(denom); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Paused(context.Background(), &types.QueryPausedRequest{
+				Denom: denom,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintOutput(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTransferPolicy queries the transfer policy of a denom
+func GetCmdQueryTransferPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "transfer-policy [denomID]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the transfer policy and the allow and deny lists of a denom
+Example:
+$ %s query nft transfer-policy <denom>`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom := strings.TrimSpace(args[0])
+			if err := types.ValidateDenomID(denom); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.TransferPolicy(context.Background(), &types.QueryTransferPolicyRequest{
+				Denom: denom,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintOutput(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryMetadata queries the ERC-721 metadata JSON of a nft
+func GetCmdQueryMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "metadata [denomID] [tokenID]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the ERC-721 metadata JSON of a nft rendered with the metadata template of its denom
+Example:
+$ %s query nft metadata <denom> <tokenID>`, version.AppName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom := strings.TrimSpace(args[0])
+			if err := types.ValidateDenomID(denom); err != nil {
+				return err
+			}
+
+			tokenID := strings.TrimSpace(args[1])
+			if err := types.ValidateTokenID(tokenID); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Metadata(context.Background(), &types.QueryMetadataRequest{
+				Denom: denom,
+				Id:    tokenID,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintOutput(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryParams queries the parameters of the nft module
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "params",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the parameters of the nft module
+Example:
+$ %s query nft params`, version.AppName)),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintOutput(&resp.Params)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdVerifyURIHash checks the content behind the uri of an NFT or a denom against its uri hash
+func GetCmdVerifyURIHash() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "verify-uri [denomID] [tokenID]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Fetch the content behind the uri of an NFT, or of the denom when tokenID is omitted,
+and check it against the uri hash stored on chain. file:// and data: uris are resolved without network access;
+with --uri-hash set together with --uri or --file the chain is not queried at all.
+Example:
+$ %s query nft verify-uri <denom> <tokenID>
+$ %s query nft verify-uri <denom> <tokenID> --file=<path>
+$ %s query nft verify-uri <denom> --uri=file:///tmp/denom.json --uri-hash=<uri-hash>`,
+				version.AppName, version.AppName, version.AppName)),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom := strings.TrimSpace(args[0])
+			if err := types.ValidateDenomID(denom); err != nil {
+				return err
+			}
+
+			uri := strings.TrimSpace(viper.GetString(FlagTokenURI))
+			uriHash := strings.TrimSpace(viper.GetString(FlagURIHash))
+			file := strings.TrimSpace(viper.GetString(FlagFile))
+
+			if len(uriHash) == 0 || (len(uri) == 0 && len(file) == 0) {
+				queryClient := types.NewQueryClient(clientCtx)
+				if len(args) == 1 {
+					resp, err := queryClient.Denom(context.Background(), &types.QueryDenomRequest{
+						Denom: denom,
+					})
+					if err != nil {
+						return err
+					}
+					if len(uri) == 0 {
+						uri = resp.Denom.URI
+					}
+					if len(uriHash) == 0 {
+						uriHash = resp.Denom.URIHash
+					}
+				} else {
+					tokenID := strings.TrimSpace(args[1])
+					if err := types.ValidateTokenID(tokenID); err != nil {
+						return err
+					}
+
+					resp, err := queryClient.NFT(context.Background(), &types.QueryNFTRequest{
+						Denom: denom,
+						Id:    tokenID,
+					})
+					if err != nil {
+						return err
+					}
+					if len(uri) == 0 {
+						uri = resp.NFT.URI
+					}
+					if len(uriHash) == 0 {
+						uriHash = resp.NFT.URIHash
+					}
+				}
+			}
+
+			if len(file) > 0 {
+				uri = file
+			}
+			content, err := fetchURIContent(uri,
+				viper.GetString(FlagIPFSGateway),
+				viper.GetDuration(FlagFetchTimeout),
+			)
+			if err != nil {
+				return err
+			}
+
+			if err := types.VerifyURIHash(uriHash, content); err != nil {
+				return err
+			}
+			return clientCtx.PrintString(fmt.Sprintf("verified %s against uri hash %s\n", uri, uriHash))
+		},
+	}
+	cmd.Flags().AddFlagSet(FsVerifyURI)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
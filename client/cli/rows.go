@@ -0,0 +1,228 @@
+package cli
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/irismod/nft/types"
+)
+
+// denomRow is a denom of a bulk file
+type denomRow struct {
+	ID               string `json:"id"`
+	Name             string `json:"name"`
+	Schema           string `json:"schema"`
+	URI              string `json:"uri"`
+	URIHash          string `json:"uri_hash"`
+	BaseURI          string `json:"base_uri"`
+	HistoryRetention uint64 `json:"history_retention"`
+	Revocable        bool   `json:"revocable"`
+	Creator          string `json:"creator"`
+}
+
+// nftRow is a NFT of a bulk file, its attributes are a JSON array in a CSV file
+type nftRow struct {
+	DenomID    string            `json:"denom_id"`
+	TokenID    string            `json:"token_id"`
+	Owner      string            `json:"owner"`
+	Name       string            `json:"name"`
+	URI        string            `json:"uri"`
+	URIHash    string            `json:"uri_hash"`
+	Data       string            `json:"data"`
+	Attributes []types.Attribute `json:"attributes"`
+}
+
+// addressMapRow maps an owner of another chain to a local address or key name
+type addressMapRow struct {
+	Original string `json:"original"`
+	Address  string `json:"address"`
+}
+
+var (
+	addressMapColumns = []string{"original", "address"}
+	denomColumns      = []string{"id", "name", "schema", "uri", "uri_hash", "base_uri", "history_retention", "revocable", "creator"}
+	nftColumns        = []string{"denom_id", "token_id", "owner", "name", "uri", "uri_hash", "data", "attributes"}
+)
+
+// readDenomRows reads the denoms of a JSON or CSV file
+func readDenomRows(path string) ([]denomRow, error) {
+	var rows []denomRow
+	err := readRows(path, &rows, denomColumns, func(record map[string]string) (err error) {
+		row := denomRow{
+			ID:      record["id"],
+			Name:    record["name"],
+			Schema:  record["schema"],
+			URI:     record["uri"],
+			URIHash: record["uri_hash"],
+			BaseURI: record["base_uri"],
+			Creator: record["creator"],
+		}
+		if s := record["history_retention"]; len(s) > 0 {
+			if row.HistoryRetention, err = strconv.ParseUint(s, 10, 64); err != nil {
+				return fmt.Errorf("invalid history_retention %s: %w", s, err)
+			}
+		}
+		if s := record["revocable"]; len(s) > 0 {
+			if row.Revocable, err = strconv.ParseBool(s); err != nil {
+				return fmt.Errorf("invalid revocable %s: %w", s, err)
+			}
+		}
+		rows = append(rows, row)
+		return nil
+	})
+	return rows, err
+}
+
+// readNFTRows reads the NFTs of a JSON or CSV file
+func readNFTRows(path string) ([]nftRow, error) {
+	var rows []nftRow
+	err := readRows(path, &rows, nftColumns, func(record map[string]string) error {
+		attributes, err := parseAttributes(record["attributes"])
+		if err != nil {
+			return err
+		}
+		rows = append(rows, nftRow{
+			DenomID:    record["denom_id"],
+			TokenID:    record["token_id"],
+			Owner:      record["owner"],
+			Name:       record["name"],
+			URI:        record["uri"],
+			URIHash:    record["uri_hash"],
+			Data:       record["data"],
+			Attributes: attributes,
+		})
+		return nil
+	})
+	return rows, err
+}
+
+// readRows decodes a JSON file holding an array of rows into rows, or passes
+// the records of a CSV file whose header names the columns to appendRecord
+func readRows(path string, rows interface{}, columns []string, appendRecord func(map[string]string) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		decoder := json.NewDecoder(f)
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(rows); err != nil {
+			return fmt.Errorf("failed to decode %s: %w", path, err)
+		}
+		return nil
+
+	case ".csv":
+		reader := csv.NewReader(f)
+		header, err := reader.Read()
+		if err != nil {
+			return fmt.Errorf("failed to read the header of %s: %w", path, err)
+		}
+		for i, column := range header {
+			header[i] = strings.ToLower(strings.TrimSpace(column))
+			if !contains(columns, header[i]) {
+				return fmt.Errorf("unknown column %q in %s, expected some of %s", column, path, strings.Join(columns, ","))
+			}
+		}
+
+		for line := 2; ; line++ {
+			values, err := reader.Read()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %w", path, err)
+			}
+
+			record := make(map[string]string, len(header))
+			for i, column := range header {
+				record[column] = strings.TrimSpace(values[i])
+			}
+			if err := appendRecord(record); err != nil {
+				return fmt.Errorf("%s line %d: %w", path, line, err)
+			}
+		}
+
+	default:
+		return fmt.Errorf("unsupported file %s, expected a .json or .csv file", path)
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// nftRowWriter streams NFT rows as a JSON array or as a CSV file readable by
+// readNFTRows
+type nftRowWriter struct {
+	w     io.Writer
+	csv   *csv.Writer
+	count int
+}
+
+func newNFTRowWriter(w io.Writer, format string) (*nftRowWriter, error) {
+	switch format {
+	case "json":
+		return &nftRowWriter{w: w}, nil
+	case "csv":
+		rw := &nftRowWriter{w: w, csv: csv.NewWriter(w)}
+		return rw, rw.csv.Write(nftColumns)
+	default:
+		return nil, fmt.Errorf("unsupported format %s, expected json or csv", format)
+	}
+}
+
+// Write writes the row
+func (rw *nftRowWriter) Write(row nftRow) error {
+	defer func() { rw.count++ }()
+
+	if rw.csv == nil {
+		bz, err := json.Marshal(row)
+		if err != nil {
+			return err
+		}
+		separator := ",\n  "
+		if rw.count == 0 {
+			separator = "[\n  "
+		}
+		_, err = fmt.Fprintf(rw.w, "%s%s", separator, bz)
+		return err
+	}
+
+	var attributes string
+	if len(row.Attributes) > 0 {
+		bz, err := json.Marshal(row.Attributes)
+		if err != nil {
+			return err
+		}
+		attributes = string(bz)
+	}
+	return rw.csv.Write([]string{row.DenomID, row.TokenID, row.Owner, row.Name, row.URI, row.URIHash, row.Data, attributes})
+}
+
+// Close terminates the JSON array or flushes the CSV rows
+func (rw *nftRowWriter) Close() error {
+	if rw.csv != nil {
+		rw.csv.Flush()
+		return rw.csv.Error()
+	}
+	if rw.count == 0 {
+		_, err := fmt.Fprintln(rw.w, "[]")
+		return err
+	}
+	_, err := fmt.Fprintln(rw.w, "\n]")
+	return err
+}
@@ -0,0 +1,175 @@
+package cli
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/irismod/nft/types"
+)
+
+// holder is an owner of NFTs of the denom of a snapshot
+type holder struct {
+	Address  string   `json:"address"`
+	Count    int      `json:"count"`
+	TokenIDs []string `json:"token_ids"`
+}
+
+// snapshot lists the holders of a denom at a height
+type snapshot struct {
+	Denom   string   `json:"denom"`
+	Height  int64    `json:"height"`
+	Holders []holder `json:"holders"`
+}
+
+// GetCmdQuerySnapshot queries the holders of a denom at a height
+func GetCmdQuerySnapshot() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "snapshot [denomID]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the holders of the NFTs of a denom at a height, the latest by default, with the number
+and the ids of the NFTs each one holds. The NFTs of the denom are walked page by page, every page at the
+height of the first one. The output is a JSON object, or CSV with the columns address,count,token_ids, the token
+ids being separated by ';'. The node must keep the state of the height.
+Example:
+$ %s query nft snapshot <denom> --height=<height> --min-tokens=2 --exclude=<address> --format=csv`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom := strings.ToLower(strings.TrimSpace(args[0]))
+			if err := types.ValidateDenomID(denom); err != nil {
+				return err
+			}
+
+			format := viper.GetString(FlagFormat)
+			if format != "json" && format != "csv" {
+				return fmt.Errorf("unsupported format %s, expected json or csv", format)
+			}
+
+			excluded := make(map[string]bool)
+			for _, address := range viper.GetStringSlice(FlagExclude) {
+				addr, err := sdk.AccAddressFromBech32(strings.TrimSpace(address))
+				if err != nil {
+					return err
+				}
+				excluded[addr.String()] = true
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			snap, err := takeSnapshot(context.Background(), queryClient, denom, viper.GetUint64(FlagPageLimit))
+			if err != nil {
+				return err
+			}
+			snap.Holders = filterHolders(snap.Holders, viper.GetInt(FlagMinTokens), excluded)
+
+			if format == "csv" {
+				fmt.Fprintf(cmd.ErrOrStderr(), "%d holders of %s at height %d\n", len(snap.Holders), snap.Denom, snap.Height)
+				return writeHoldersCSV(cmd.OutOrStdout(), snap.Holders)
+			}
+			encoder := json.NewEncoder(cmd.OutOrStdout())
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(snap)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsSnapshot)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// takeSnapshot walks the NFTs of the denom through the Collection query, the
+// pages following the first one are queried at its height. Only the prefix of
+// the denom is iterated, the NFTs are grouped by owner and the holders sorted
+// by address.
+func takeSnapshot(ctx context.Context, queryClient types.QueryClient, denom string, limit uint64) (snapshot, error) {
+	snap := snapshot{Denom: denom, Holders: []holder{}}
+	holders := make(map[string]int)
+
+	var nextKey []byte
+	for {
+		var header metadata.MD
+		res, err := queryClient.Collection(ctx, &types.QueryCollectionRequest{
+			Denom:      denom,
+			Pagination: &query.PageRequest{Key: nextKey, Limit: limit},
+		}, grpc.Header(&header))
+		if err != nil {
+			return snap, err
+		}
+		if heights := header.Get(grpctypes.GRPCBlockHeightHeader); len(heights) > 0 && len(nextKey) == 0 {
+			if snap.Height, err = strconv.ParseInt(heights[0], 10, 64); err != nil {
+				return snap, err
+			}
+			ctx = metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, heights[0])
+		}
+
+		if res.Collection != nil {
+			for _, nft := range res.Collection.NFTs {
+				address := nft.Owner.String()
+				i, ok := holders[address]
+				if !ok {
+					i = len(snap.Holders)
+					holders[address] = i
+					snap.Holders = append(snap.Holders, holder{Address: address})
+				}
+				snap.Holders[i].TokenIDs = append(snap.Holders[i].TokenIDs, nft.Id)
+				snap.Holders[i].Count++
+			}
+		}
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			sort.Slice(snap.Holders, func(i, j int) bool {
+				return snap.Holders[i].Address < snap.Holders[j].Address
+			})
+			return snap, nil
+		}
+		nextKey = res.Pagination.NextKey
+	}
+}
+
+// filterHolders drops the holders of less than minTokens NFTs and the
+// excluded addresses
+func filterHolders(holders []holder, minTokens int, excluded map[string]bool) []holder {
+	filtered := holders[:0]
+	for _, h := range holders {
+		if h.Count >= minTokens && !excluded[h.Address] {
+			filtered = append(filtered, h)
+		}
+	}
+	return filtered
+}
+
+func writeHoldersCSV(w io.Writer, holders []holder) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"address", "count", "token_ids"}); err != nil {
+		return err
+	}
+	for _, h := range holders {
+		if err := writer.Write([]string{h.Address, strconv.Itoa(h.Count), strings.Join(h.TokenIDs, ";")}); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
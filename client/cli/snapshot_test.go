@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/irismod/nft/types"
+)
+
+// collectionQueryClient serves the pages of the Collection query at height
+// 10, the other methods are left to the nil embedded interface
+type collectionQueryClient struct {
+	types.QueryClient
+
+	pages   [][]types.BaseNFT
+	heights []string
+}
+
+func (c *collectionQueryClient) Collection(ctx context.Context, req *types.QueryCollectionRequest, opts ...grpc.CallOption) (*types.QueryCollectionResponse, error) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	c.heights = append(c.heights, md.Get(grpctypes.GRPCBlockHeightHeader)...)
+	for _, opt := range opts {
+		if header, ok := opt.(grpc.HeaderCallOption); ok {
+			*header.HeaderAddr = metadata.Pairs(grpctypes.GRPCBlockHeightHeader, "10")
+		}
+	}
+
+	page := 0
+	if len(req.Pagination.Key) > 0 {
+		page = int(req.Pagination.Key[0])
+	}
+	res := &types.QueryCollectionResponse{
+		Collection: &types.Collection{Denom: types.Denom{Id: req.Denom}, NFTs: c.pages[page]},
+		Pagination: &query.PageResponse{},
+	}
+	if page+1 < len(c.pages) {
+		res.Pagination.NextKey = []byte{byte(page + 1)}
+	}
+	return res, nil
+}
+
+func TestSnapshot(t *testing.T) {
+	alice := sdk.AccAddress("alice_______________")
+	bob := sdk.AccAddress("bob_________________")
+	nft := func(id string, owner sdk.AccAddress) types.BaseNFT {
+		return types.BaseNFT{Id: id, Owner: owner}
+	}
+
+	client := &collectionQueryClient{pages: [][]types.BaseNFT{
+		{nft("kitty1", bob), nft("kitty2", alice)},
+		// the NFTs of alice span two pages
+		{nft("kitty3", alice), nft("kitty4", alice)},
+	}}
+	snap, err := takeSnapshot(context.Background(), client, "kitty", 2)
+	require.NoError(t, err)
+	require.Equal(t, snapshot{Denom: "kitty", Height: 10, Holders: []holder{
+		{Address: alice.String(), Count: 3, TokenIDs: []string{"kitty2", "kitty3", "kitty4"}},
+		{Address: bob.String(), Count: 1, TokenIDs: []string{"kitty1"}},
+	}}, snap)
+	// the second page is queried at the height of the first one
+	require.Equal(t, []string{"10"}, client.heights)
+
+	require.Len(t, filterHolders(append([]holder{}, snap.Holders...), 2, nil), 1)
+	require.Equal(t, []holder{snap.Holders[1]}, filterHolders(append([]holder{}, snap.Holders...), 1, map[string]bool{alice.String(): true}))
+
+	var buf bytes.Buffer
+	require.NoError(t, writeHoldersCSV(&buf, snap.Holders))
+	require.Equal(t, "address,count,token_ids\n"+alice.String()+",3,kitty2;kitty3;kitty4\n"+bob.String()+",1,kitty1\n", buf.String())
+}
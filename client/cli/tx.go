@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -31,6 +32,16 @@ func NewTxCmd() *cobra.Command {
 		GetCmdEditNFT(),
 		GetCmdTransferNFT(),
 		GetCmdBurnNFT(),
+		GetCmdRevokeNFT(),
+		GetCmdPauseDenom(),
+		GetCmdUnpauseDenom(),
+		GetCmdSetTransferPolicy(),
+		GetCmdUpdatePolicyList(),
+		GetCmdSetMetadataTemplate(),
+		GetCmdGrantAuthorization(),
+		GetCmdRevokeAuthorization(),
+		GetCmdExecAuthorized(),
+		GetCmdImportCollection(),
 	)
 
 	return txCmd
@@ -43,7 +54,7 @@ func GetCmdIssueDenom() *cobra.Command {
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Issue a new denom.
 Example:
-$ %s tx nft issue [denomID] --from=<key-name> --name=<name> --schema=<schema> --name=<name> --chain-id=<chain-id> --fees=<fee>`,
+$ %s tx nft issue [denomID] --from=<key-name> --name=<name> --schema=<schema> --uri=<uri> --uri-hash=<uri-hash> --base-uri=<base-uri> --history-retention=<history-retention> --chain-id=<chain-id> --fees=<fee>`,
 				version.AppName,
 			),
 		),
@@ -58,7 +69,12 @@ $ %s tx nft issue [denomID] --from=<key-name> --name=<name> --schema=<schema> --
 			msg := types.NewMsgIssueDenom(args[0],
 				viper.GetString(FlagDenomName),
 				viper.GetString(FlagSchema),
-				clientCtx.GetFromAddress(),
+				viper.GetString(FlagTokenURI),
+				viper.GetString(FlagURIHash),
+				viper.GetString(FlagBaseURI),
+				viper.GetUint64(FlagHistoryRetention),
+				viper.GetBool(FlagRevocable),
+				clientCtx.GetFromAddress().String(),
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -79,7 +95,7 @@ func GetCmdMintNFT() *cobra.Command {
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Mint an NFT and set the owner to the recipient.
 Example:
-$ %s tx nft mint [denomID] [tokenID] --uri=<uri> --recipient=<recipient> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+$ %s tx nft mint [denomID] [tokenID] --uri=<uri> --uri-hash=<uri-hash> --attributes=<attributes> --recipient=<recipient> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
 				version.AppName,
 			),
 		),
@@ -101,14 +117,21 @@ $ %s tx nft mint [denomID] [tokenID] --uri=<uri> --recipient=<recipient> --from=
 				}
 			}
 
+			attributes, err := parseAttributes(viper.GetString(FlagAttributes))
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgMintNFT(
 				args[1],
 				args[0],
 				viper.GetString(FlagTokenName),
 				viper.GetString(FlagTokenURI),
+				viper.GetString(FlagURIHash),
 				viper.GetString(FlagTokenData),
-				clientCtx.GetFromAddress(),
-				recipient,
+				attributes,
+				clientCtx.GetFromAddress().String(),
+				recipient.String(),
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -129,7 +152,9 @@ func GetCmdEditNFT() *cobra.Command {
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Edit the tokenData of an NFT.
 Example:
-$ %s tx nft edit [denomID] [tokenID] --uri=<uri> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+$ %s tx nft edit [denomID] [tokenID] --uri=<uri> --uri-hash=<uri-hash> --attributes=<attributes> --from=<key-name> --chain-id=<chain-id> --fees=<fee>
+$ %s tx nft edit [denomID] [tokenID] --clear-attributes --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
 				version.AppName,
 			),
 		),
@@ -141,14 +166,22 @@ $ %s tx nft edit [denomID] [tokenID] --uri=<uri> --from=<key-name> --chain-id=<c
 				return err
 			}
 
+			attributes, err := parseAttributes(viper.GetString(FlagAttributes))
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgEditNFT(
 				args[1],
 				args[0],
 				viper.GetString(FlagTokenName),
 				viper.GetString(FlagTokenURI),
+				viper.GetString(FlagURIHash),
 				viper.GetString(FlagTokenData),
-				clientCtx.GetFromAddress(),
+				attributes,
+				clientCtx.GetFromAddress().String(),
 			)
+			msg.ClearAttributes = viper.GetBool(FlagClearAttributes)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -168,7 +201,7 @@ func GetCmdTransferNFT() *cobra.Command {
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Transfer a NFT to a recipient.
 Example:
-$ %s tx nft transfer [recipient] [denomID] [tokenID] --uri=<uri> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+$ %s tx nft transfer [recipient] [denomID] [tokenID] --uri=<uri> --uri-hash=<uri-hash> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
 				version.AppName,
 			),
 		),
@@ -190,9 +223,10 @@ $ %s tx nft transfer [recipient] [denomID] [tokenID] --uri=<uri> --from=<key-nam
 				args[1],
 				viper.GetString(FlagTokenName),
 				viper.GetString(FlagTokenURI),
+				viper.GetString(FlagURIHash),
 				viper.GetString(FlagTokenData),
-				clientCtx.GetFromAddress(),
-				recipient,
+				clientCtx.GetFromAddress().String(),
+				recipient.String(),
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -225,14 +259,268 @@ $ %s tx nft burn [denomID] [tokenID] --from=<key-name> --chain-id=<chain-id> --f
 				return err
 			}
 
-			msg := types.NewMsgBurnNFT(clientCtx.GetFromAddress(), args[1], args[0])
+			msg := types.NewMsgBurnNFT(clientCtx.GetFromAddress().String(), args[1], args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRevokeNFT is the CLI command for a RevokeNFT transaction
+func GetCmdRevokeNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "revoke [denomID] [tokenID]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke an NFT of a revocable denom, only the denom creator is allowed.
+The NFT is burned, or reclaimed to the recipient when set.
+Example:
+$ %s tx nft revoke [denomID] [tokenID] --recipient=<recipient> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			recipient, err := cmd.Flags().GetString(FlagRecipient)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeNFT(args[1], args[0], clientCtx.GetFromAddress().String(), strings.TrimSpace(recipient))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsRevokeNFT)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdPauseDenom is the CLI command for a PauseDenom transaction
+func GetCmdPauseDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "pause [denomID]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Pause the mints, edits, transfers and burns of a denom, only the denom creator is allowed.
+Example:
+$ %s tx nft pause [denomID] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPauseDenom(args[0], clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdUnpauseDenom is the CLI command for a UnpauseDenom transaction
+func GetCmdUnpauseDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "unpause [denomID]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Unpause a denom paused by its creator.
+Example:
+$ %s tx nft unpause [denomID] --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnpauseDenom(args[0], clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSetTransferPolicy is the CLI command for a SetTransferPolicy transaction
+func GetCmdSetTransferPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "set-transfer-policy [denomID] [policy]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the transfer policy evaluated on the mints and transfers of a denom, only the denom creator is allowed.
+The built-in policies are %s and %s, omitting the policy removes the restrictions.
+Example:
+$ %s tx nft set-transfer-policy [denomID] %s --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				types.PolicyAllowList, types.PolicyDenyList, version.AppName, types.PolicyAllowList,
+			),
+		),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var policy string
+			if len(args) > 1 {
+				policy = args[1]
+			}
+
+			msg := types.NewMsgSetTransferPolicy(args[0], policy, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSetMetadataTemplate is the CLI command for a SetMetadataTemplate transaction
+func GetCmdSetMetadataTemplate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "set-metadata-template [denomID]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the JSON pointers into the token data the ERC-721 metadata of the tokens of a denom is read from, only the denom creator is allowed.
+The fields without a pointer are left empty, omitting every flag restores the default template.
+Example:
+$ %s tx nft set-metadata-template [denomID] --description=/desc --image=/media/0/url --attributes=/traits --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			fields := []string{FlagDescription, FlagImage, FlagExternalURL, FlagAnimationURL, FlagAttributes}
+			pointers := make(map[string]string, len(fields))
+			for _, field := range fields {
+				if !cmd.Flags().Changed(field) {
+					continue
+				}
+				if pointers[field], err = cmd.Flags().GetString(field); err != nil {
+					return err
+				}
+			}
+
+			var template *types.MetadataTemplate
+			if len(pointers) > 0 {
+				template = &types.MetadataTemplate{
+					Description:  pointers[FlagDescription],
+					Image:        pointers[FlagImage],
+					ExternalURL:  pointers[FlagExternalURL],
+					AnimationURL: pointers[FlagAnimationURL],
+					Attributes:   pointers[FlagAttributes],
+				}
+			}
+
+			msg := types.NewMsgSetMetadataTemplate(args[0], template, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsMetadataTemplate)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdUpdatePolicyList is the CLI command for a UpdatePolicyList transaction
+func GetCmdUpdatePolicyList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "update-policy-list [denomID] [allow|deny]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Add or remove addresses of the allow or deny list of a denom, only the denom creator is allowed.
+Example:
+$ %s tx nft update-policy-list [denomID] allow --add=<address>,<address> --remove=<address> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			add, err := cmd.Flags().GetStringSlice(FlagAdd)
+			if err != nil {
+				return err
+			}
+
+			remove, err := cmd.Flags().GetStringSlice(FlagRemove)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdatePolicyList(args[0], args[1], add, remove, clientCtx.GetFromAddress().String())
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	cmd.Flags().AddFlagSet(FsPolicyList)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// parseAttributes decodes the JSON encoded attributes of the --attributes flag
+func parseAttributes(attributesStr string) ([]types.Attribute, error) {
+	attributesStr = strings.TrimSpace(attributesStr)
+	switch attributesStr {
+	case "":
+		return nil, nil
+	case types.DoNotModify:
+		return types.DoNotModifyAttributes(), nil
+	}
+
+	var attributes []types.Attribute
+	if err := json.Unmarshal([]byte(attributesStr), &attributes); err != nil {
+		return nil, fmt.Errorf("invalid attributes %s: %w", attributesStr, err)
+	}
+	for i, attr := range attributes {
+		attributes[i] = types.NewAttribute(attr.Key, attr.Value, attr.Type)
+	}
+	return attributes, nil
+}
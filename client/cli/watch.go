@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/irismod/nft/client/watch"
+	"github.com/irismod/nft/types"
+)
+
+// GetCmdWatch streams the events of the module as JSON lines
+func GetCmdWatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "watch [denomID]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Stream the events of the NFT module as JSON lines until interrupted, reconnecting to the node when the connection is lost.
+Only the events of the denom are streamed when given.
+Example:
+$ %s query nft watch <denom> --from-height=<height> --event-types=transfer_nft,mint_nft`, version.AppName)),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			cfg := watch.Config{
+				FromHeight: viper.GetInt64(FlagFromHeight),
+				EventTypes: viper.GetStringSlice(FlagEventTypes),
+			}
+			if len(args) > 0 {
+				cfg.Denom = strings.TrimSpace(args[0])
+				if err := types.ValidateDenomID(cfg.Denom); err != nil {
+					return err
+				}
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			sigs := make(chan os.Signal, 1)
+			signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+			go func() {
+				<-sigs
+				cancel()
+			}()
+
+			dial := func() (rpcclient.Client, error) {
+				return rpchttp.New(clientCtx.NodeURI, "/websocket")
+			}
+			encoder := json.NewEncoder(cmd.OutOrStdout())
+			err = watch.Watch(ctx, dial, cfg, func(event watch.Event) error {
+				return encoder.Encode(event)
+			})
+			if err == context.Canceled {
+				return nil
+			}
+			return err
+		},
+	}
+	cmd.Flags().AddFlagSet(FsWatch)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,248 @@
+// Package convert turns the collections of other chains, CW721 contract
+// exports and ERC-721 holder lists, into collections of the genesis state.
+// The token ids rejected by types.ValidateTokenID are mapped to valid ids, the
+// original id being kept in the data of the NFT, and the owners are converted
+// to local addresses. A Report lists every mapping.
+package convert
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	"github.com/irismod/nft/types"
+)
+
+// OriginalIDKey is the key of the data of a NFT holding the original id of a
+// mapped token
+const OriginalIDKey = "original_token_id"
+
+// hashedIDLen is the number of hex characters of the digest of the original
+// id used by the ids that can't be derived from it
+const hashedIDLen = 16
+
+var nonAlphaNumeric = regexp.MustCompile(`[^a-z0-9]`)
+
+// Token is a token of a foreign collection
+type Token struct {
+	ID    string
+	Owner string
+	URI   string
+	// Data is the JSON encoded metadata of the token, if any
+	Data string
+}
+
+// Source is a foreign collection
+type Source struct {
+	Name   string
+	Symbol string
+	Tokens []Token
+}
+
+// Config defines the converted denom and how the owners are converted
+type Config struct {
+	DenomID string
+	// DenomName defaults to the name of the source
+	DenomName string
+	Schema    string
+	BaseURI   string
+	Creator   sdk.AccAddress
+	// IDPrefix prefixes the mapped token ids, the denom id by default
+	IDPrefix string
+	// AddressMap maps the foreign owners, e.g. 0x addresses, to local ones, it
+	// takes precedence over the conversion of the bech32 addresses
+	AddressMap map[string]sdk.AccAddress
+	// ClaimAddress receives the tokens of the owners that can't be converted,
+	// they fail the conversion when empty
+	ClaimAddress sdk.AccAddress
+}
+
+// Owner conversions
+const (
+	OwnerMapped  = "mapped"
+	OwnerBech32  = "bech32"
+	OwnerClaimed = "claimed"
+)
+
+// IDMapping is a token id that was changed
+type IDMapping struct {
+	Original string `json:"original"`
+	TokenID  string `json:"token_id"`
+}
+
+// OwnerMapping is a foreign owner converted to a local address
+type OwnerMapping struct {
+	Original   string `json:"original"`
+	Address    string `json:"address"`
+	Conversion string `json:"conversion"`
+	Tokens     int    `json:"tokens"`
+}
+
+// Report lists the mappings of a conversion
+type Report struct {
+	Denom  string         `json:"denom"`
+	Tokens int            `json:"tokens"`
+	IDs    []IDMapping    `json:"ids"`
+	Owners []OwnerMapping `json:"owners"`
+}
+
+// Convert returns the collection of the source, validated by
+// types.ValidateGenesis, and the report of the conversion
+func Convert(src Source, cfg Config) (types.Collection, Report, error) {
+	denomID := strings.ToLower(strings.TrimSpace(cfg.DenomID))
+	name := cfg.DenomName
+	if len(name) == 0 {
+		name = src.Name
+	}
+	prefix := strings.ToLower(cfg.IDPrefix)
+	if len(prefix) == 0 {
+		prefix = denomID
+	}
+	if !types.IsBeginWithAlpha(prefix) || !types.IsAlphaNumeric(prefix) || len(prefix) > types.MaxDenomLen-hashedIDLen {
+		return types.Collection{}, Report{}, fmt.Errorf("invalid id prefix %s, only accepts at most %d alphanumeric characters beginning with an english letter",
+			prefix, types.MaxDenomLen-hashedIDLen)
+	}
+
+	denom := types.NewDenom(denomID, strings.TrimSpace(name), cfg.Schema, "", "", cfg.BaseURI, 0, false, cfg.Creator)
+	collection := types.NewCollection(denom, nil)
+	report := Report{Denom: denomID, Tokens: len(src.Tokens), IDs: []IDMapping{}, Owners: []OwnerMapping{}}
+
+	// the ids already valid are kept first, so that a mapped id never takes
+	// the id of another token
+	taken := make(map[string]bool, len(src.Tokens))
+	originals := make(map[string]bool, len(src.Tokens))
+	for _, token := range src.Tokens {
+		if originals[token.ID] {
+			return types.Collection{}, Report{}, fmt.Errorf("duplicated token id %s", token.ID)
+		}
+		originals[token.ID] = true
+		if id := strings.ToLower(token.ID); id == token.ID && types.ValidateTokenID(id) == nil {
+			taken[id] = true
+		}
+	}
+
+	owners := make(map[string]int)
+	var unconverted []string
+	for _, token := range src.Tokens {
+		tokenID, data := token.ID, token.Data
+		if !taken[tokenID] {
+			tokenID = mapTokenID(prefix, token.ID, taken)
+			taken[tokenID] = true
+			report.IDs = append(report.IDs, IDMapping{Original: token.ID, TokenID: tokenID})
+
+			var err error
+			if data, err = withOriginalID(data, token.ID); err != nil {
+				return types.Collection{}, Report{}, err
+			}
+		}
+
+		owner, conversion := convertOwner(token.Owner, cfg)
+		if owner.Empty() {
+			unconverted = append(unconverted, token.Owner)
+			continue
+		}
+		if i, ok := owners[token.Owner]; ok {
+			report.Owners[i].Tokens++
+		} else {
+			owners[token.Owner] = len(report.Owners)
+			report.Owners = append(report.Owners, OwnerMapping{
+				Original:   token.Owner,
+				Address:    owner.String(),
+				Conversion: conversion,
+				Tokens:     1,
+			})
+		}
+
+		nft := types.NewBaseNFT(tokenID, "", owner, strings.TrimSpace(token.URI), "", data, nil)
+		collection = collection.AddNFT(nft)
+	}
+	if len(unconverted) > 0 {
+		return types.Collection{}, Report{}, fmt.Errorf("%d tokens are owned by addresses missing from the address map, e.g. %s, set a claim address to convert them anyway",
+			len(unconverted), unconverted[0])
+	}
+	sort.Slice(report.Owners, func(i, j int) bool { return report.Owners[i].Original < report.Owners[j].Original })
+
+	genesis := types.NewGenesisState(types.DefaultParams(), []types.Collection{collection}, nil, nil, nil, nil, nil, nil, nil)
+	if err := types.ValidateGenesis(*genesis); err != nil {
+		return types.Collection{}, Report{}, err
+	}
+	return collection, report, nil
+}
+
+// mapTokenID returns a valid id not taken yet for the original id, the
+// lowercased original id or its prefixed alphanumeric characters when
+// possible, the prefixed digest of the original id otherwise
+func mapTokenID(prefix, original string, taken map[string]bool) string {
+	lower := strings.ToLower(original)
+	for _, id := range []string{lower, prefix + nonAlphaNumeric.ReplaceAllString(lower, "")} {
+		if types.ValidateTokenID(id) == nil && !taken[id] {
+			return id
+		}
+	}
+
+	for n := 0; ; n++ {
+		seed := original
+		if n > 0 {
+			seed = fmt.Sprintf("%s#%d", original, n)
+		}
+		digest := sha256.Sum256([]byte(seed))
+		id := prefix + hex.EncodeToString(digest[:])[:hashedIDLen]
+		if !taken[id] {
+			return id
+		}
+	}
+}
+
+// withOriginalID adds the original id to the data of a token, a JSON object
+// gets a new key while the other data is wrapped in an object
+func withOriginalID(data, original string) (string, error) {
+	object := make(map[string]json.RawMessage)
+	if len(strings.TrimSpace(data)) > 0 {
+		if err := json.Unmarshal([]byte(data), &object); err != nil || object == nil {
+			object = make(map[string]json.RawMessage)
+			raw, err := json.Marshal(data)
+			if err != nil {
+				return "", err
+			}
+			object["data"] = raw
+		}
+	}
+
+	raw, err := json.Marshal(original)
+	if err != nil {
+		return "", err
+	}
+	object[OriginalIDKey] = raw
+
+	bz, err := json.Marshal(object)
+	if err != nil {
+		return "", err
+	}
+	return string(bz), nil
+}
+
+// convertOwner returns the local address of the owner and how it was
+// converted, or an empty address when it can't be converted
+func convertOwner(owner string, cfg Config) (sdk.AccAddress, string) {
+	owner = strings.TrimSpace(owner)
+	if address, ok := cfg.AddressMap[owner]; ok {
+		return address, OwnerMapped
+	}
+	if address, ok := cfg.AddressMap[strings.ToLower(owner)]; ok {
+		return address, OwnerMapped
+	}
+	if _, bz, err := bech32.DecodeAndConvert(owner); err == nil && len(bz) > 0 {
+		return sdk.AccAddress(bz), OwnerBech32
+	}
+	if !cfg.ClaimAddress.Empty() {
+		return cfg.ClaimAddress, OwnerClaimed
+	}
+	return nil, ""
+}
@@ -0,0 +1,113 @@
+package convert
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	"github.com/irismod/nft/types"
+)
+
+var (
+	alice = sdk.AccAddress("alice_______________")
+	bob   = sdk.AccAddress("bob_________________")
+	claim = sdk.AccAddress("claim_______________")
+)
+
+func TestReadCW721(t *testing.T) {
+	src, err := ReadCW721(strings.NewReader(`{
+  "contract_info": {"name": "Kitties", "symbol": "KIT"},
+  "tokens": [
+    {"token_id": "1", "owner": "juno1alice", "token_uri": "ipfs://1", "extension": {"color": "grey"}},
+    {"token_id": "kitty2", "access": {"owner": "juno1bob"}, "info": {"token_uri": "ipfs://2", "extension": null}}
+  ]
+}`))
+	require.NoError(t, err)
+	require.Equal(t, Source{Name: "Kitties", Symbol: "KIT", Tokens: []Token{
+		{ID: "1", Owner: "juno1alice", URI: "ipfs://1", Data: `{"color": "grey"}`},
+		{ID: "kitty2", Owner: "juno1bob", URI: "ipfs://2"},
+	}}, src)
+
+	_, err = ReadCW721(strings.NewReader(`{"tokens": [{"owner": "juno1alice"}]}`))
+	require.Error(t, err)
+}
+
+func TestReadERC721(t *testing.T) {
+	src, err := ReadERC721(strings.NewReader("HolderAddress,Token_ID,Quantity\n0xAbC,1,1\n0xdef,2,1\n"))
+	require.NoError(t, err)
+	require.Equal(t, Source{Tokens: []Token{{ID: "1", Owner: "0xAbC"}, {ID: "2", Owner: "0xdef"}}}, src)
+
+	_, err = ReadERC721(strings.NewReader("holder,quantity\n0xabc,1\n"))
+	require.Error(t, err)
+}
+
+func TestConvert(t *testing.T) {
+	foreignBob, err := bech32.ConvertAndEncode("juno", bob)
+	require.NoError(t, err)
+
+	src := Source{Name: "Kitties", Tokens: []Token{
+		{ID: "kitty1", Owner: "0xABC", URI: "ipfs://1"},
+		{ID: "1", Owner: foreignBob, Data: `{"color":"grey"}`},
+		{ID: "Kitty-3", Owner: "0xdef", Data: "plain"},
+		{ID: "Kitty1", Owner: "0xabc"},
+		{ID: "#", Owner: "0xabc"},
+	}}
+	cfg := Config{
+		DenomID:      "kitty",
+		Creator:      alice,
+		AddressMap:   map[string]sdk.AccAddress{"0xabc": alice},
+		ClaimAddress: claim,
+	}
+
+	collection, report, err := Convert(src, cfg)
+	require.NoError(t, err)
+	require.Equal(t, "Kitties", collection.Denom.Name)
+
+	ids := make(map[string]types.BaseNFT)
+	for _, nft := range collection.NFTs {
+		ids[nft.Id] = nft
+	}
+	require.Len(t, ids, 5)
+	require.Equal(t, alice, ids["kitty1"].Owner)
+	require.Equal(t, "", ids["kitty1"].Data)
+	require.Equal(t, claim, ids["kittykitty3"].Owner)
+
+	// "kitty1" being taken, the id of "1" is derived from its digest
+	hashed := report.IDs[0].TokenID
+	require.Len(t, hashed, len("kitty")+hashedIDLen)
+	require.Equal(t, bob, ids[hashed].Owner)
+	require.Equal(t, []IDMapping{
+		{Original: "1", TokenID: hashed},
+		{Original: "Kitty-3", TokenID: "kittykitty3"},
+		{Original: "Kitty1", TokenID: "kittykitty1"},
+		{Original: "#", TokenID: "kitty"},
+	}, report.IDs)
+
+	var data map[string]string
+	require.NoError(t, json.Unmarshal([]byte(ids[hashed].Data), &data))
+	require.Equal(t, map[string]string{"color": "grey", OriginalIDKey: "1"}, data)
+	data = nil
+	require.NoError(t, json.Unmarshal([]byte(ids["kittykitty3"].Data), &data))
+	require.Equal(t, map[string]string{"data": "plain", OriginalIDKey: "Kitty-3"}, data)
+
+	require.Equal(t, []OwnerMapping{
+		{Original: "0xABC", Address: alice.String(), Conversion: OwnerMapped, Tokens: 1},
+		{Original: "0xabc", Address: alice.String(), Conversion: OwnerMapped, Tokens: 2},
+		{Original: "0xdef", Address: claim.String(), Conversion: OwnerClaimed, Tokens: 1},
+		{Original: foreignBob, Address: bob.String(), Conversion: OwnerBech32, Tokens: 1},
+	}, report.Owners)
+
+	// the owners missing from the address map fail the conversion without a
+	// claim address
+	cfg.ClaimAddress = nil
+	_, _, err = Convert(src, cfg)
+	require.Error(t, err)
+
+	_, _, err = Convert(Source{Tokens: []Token{{ID: "a1", Owner: foreignBob}, {ID: "a1", Owner: foreignBob}}}, cfg)
+	require.Error(t, err)
+}
@@ -0,0 +1,129 @@
+package convert
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// cw721Export is a CW721 contract export, the tokens being either flattened
+// or shaped as the all_nft_info query response
+type cw721Export struct {
+	ContractInfo struct {
+		Name   string `json:"name"`
+		Symbol string `json:"symbol"`
+	} `json:"contract_info"`
+	Tokens []cw721Token `json:"tokens"`
+}
+
+type cw721Token struct {
+	TokenID   string          `json:"token_id"`
+	Owner     string          `json:"owner"`
+	TokenURI  string          `json:"token_uri"`
+	Extension json.RawMessage `json:"extension"`
+	Access    struct {
+		Owner string `json:"owner"`
+	} `json:"access"`
+	Info struct {
+		TokenURI  string          `json:"token_uri"`
+		Extension json.RawMessage `json:"extension"`
+	} `json:"info"`
+}
+
+// ReadCW721 reads a CW721 contract export, a JSON object with the
+// contract_info and the tokens of the contract, each one with its token_id,
+// owner, token_uri and extension, or its token_id, access and info as returned
+// by the all_nft_info query. The extension becomes the data of the NFT.
+func ReadCW721(r io.Reader) (Source, error) {
+	var export cw721Export
+	if err := json.NewDecoder(r).Decode(&export); err != nil {
+		return Source{}, fmt.Errorf("failed to decode the CW721 export: %w", err)
+	}
+
+	src := Source{
+		Name:   export.ContractInfo.Name,
+		Symbol: export.ContractInfo.Symbol,
+		Tokens: make([]Token, len(export.Tokens)),
+	}
+	for i, token := range export.Tokens {
+		owner, uri, extension := token.Owner, token.TokenURI, token.Extension
+		if len(owner) == 0 {
+			owner = token.Access.Owner
+		}
+		if len(uri) == 0 {
+			uri = token.Info.TokenURI
+		}
+		if len(extension) == 0 {
+			extension = token.Info.Extension
+		}
+		if len(token.TokenID) == 0 {
+			return Source{}, fmt.Errorf("token %d of the CW721 export: empty token_id", i+1)
+		}
+
+		var data string
+		if s := strings.TrimSpace(string(extension)); len(s) > 0 && s != "null" {
+			data = s
+		}
+		src.Tokens[i] = Token{ID: token.TokenID, Owner: owner, URI: uri, Data: data}
+	}
+	return src, nil
+}
+
+// erc721Columns are the accepted names of the columns of an ERC-721 holder
+// list, compared without case, spaces and underscores
+var erc721Columns = map[string][]string{
+	"id":    {"tokenid", "id"},
+	"owner": {"owner", "holderaddress", "holder", "address"},
+	"uri":   {"tokenuri", "uri"},
+}
+
+// ReadERC721 reads an ERC-721 holder list, a CSV file with a header naming the
+// token id, the owner and optionally the token URI columns, as exported by
+// the block explorers. The other columns are ignored.
+func ReadERC721(r io.Reader) (Source, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err != nil {
+		return Source{}, fmt.Errorf("failed to read the header of the ERC-721 holder list: %w", err)
+	}
+
+	index := map[string]int{"id": -1, "owner": -1, "uri": -1}
+	for i, name := range header {
+		name = strings.NewReplacer("_", "", " ", "").Replace(strings.ToLower(strings.TrimSpace(name)))
+		for column, aliases := range erc721Columns {
+			for _, alias := range aliases {
+				if name == alias && index[column] < 0 {
+					index[column] = i
+				}
+			}
+		}
+	}
+	if index["id"] < 0 || index["owner"] < 0 {
+		return Source{}, fmt.Errorf("the ERC-721 holder list has no token id or owner column")
+	}
+
+	var src Source
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return src, nil
+		}
+		if err != nil {
+			return Source{}, err
+		}
+
+		field := func(column string) string {
+			if i := index[column]; i >= 0 && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		if len(field("id")) == 0 {
+			return Source{}, fmt.Errorf("line %d of the ERC-721 holder list: empty token id", line)
+		}
+		src.Tokens = append(src.Tokens, Token{ID: field("id"), Owner: field("owner"), URI: field("uri")})
+	}
+}
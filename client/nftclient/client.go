@@ -0,0 +1,351 @@
+// Package nftclient is a Go client of the NFT module, it builds, signs and
+// broadcasts the NFT transactions and runs the NFT queries over gRPC.
+package nftclient
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/gogo/protobuf/proto"
+
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/grpc/simulate"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/irismod/nft/types"
+)
+
+const (
+	// DefaultGasAdjustment is the multiplier applied to the simulated gas
+	DefaultGasAdjustment = 1.5
+	// DefaultMaxRetries is the number of times a failed broadcast is retried
+	DefaultMaxRetries = 3
+	// DefaultRetryInterval is the delay before retrying a failed broadcast
+	DefaultRetryInterval = time.Second
+	// DefaultTimeout is how long a broadcasted transaction is waited for
+	DefaultTimeout = 30 * time.Second
+)
+
+// Config defines the chain and the fee settings of a Client
+type Config struct {
+	ChainID string
+	// NodeURI is the Tendermint RPC endpoint the transactions are broadcasted
+	// to, the SDK doesn't serve a gRPC tx service yet
+	NodeURI       string
+	GasPrices     string
+	GasAdjustment float64
+	MaxRetries    int
+	RetryInterval time.Duration
+	Timeout       time.Duration
+}
+
+// Client signs the NFT transactions with the keys of a keyring, it tracks the
+// account number and sequence of every signer so that the transactions of a
+// signer can be sent back to back, also from several goroutines
+type Client struct {
+	cfg      Config
+	keyring  keyring.Keyring
+	registry codectypes.InterfaceRegistry
+	txConfig client.TxConfig
+
+	rpc      rpcclient.Client
+	query    types.QueryClient
+	auth     authtypes.QueryClient
+	simulate simulate.SimulateServiceClient
+
+	mu       sync.Mutex
+	accounts map[string]*account
+}
+
+// account is the signing state of a signer
+type account struct {
+	sync.Mutex
+	number   uint64
+	sequence uint64
+	loaded   bool
+}
+
+// New creates a Client querying the chain over the gRPC connection and
+// signing with the keys of the keyring
+func New(cfg Config, conn gogogrpc.ClientConn, kr keyring.Keyring) (*Client, error) {
+	if len(strings.TrimSpace(cfg.ChainID)) == 0 {
+		return nil, errors.New("chain id required but not specified")
+	}
+	if cfg.GasAdjustment <= 0 {
+		cfg.GasAdjustment = DefaultGasAdjustment
+	}
+	if cfg.MaxRetries <= 0 {
+		cfg.MaxRetries = DefaultMaxRetries
+	}
+	if cfg.RetryInterval <= 0 {
+		cfg.RetryInterval = DefaultRetryInterval
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+	if _, err := sdk.ParseDecCoins(cfg.GasPrices); err != nil {
+		return nil, err
+	}
+
+	rpc, err := rpchttp.New(cfg.NodeURI, "/websocket")
+	if err != nil {
+		return nil, err
+	}
+
+	registry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(registry)
+	authtypes.RegisterInterfaces(registry)
+	types.RegisterInterfaces(registry)
+	marshaler := codec.NewProtoCodec(registry)
+
+	return &Client{
+		cfg:      cfg,
+		keyring:  kr,
+		registry: registry,
+		txConfig: authtx.NewTxConfig(marshaler, std.DefaultPublicKeyCodec{}, authtx.DefaultSignModes),
+		rpc:      rpc,
+		query:    types.NewQueryClient(conn),
+		auth:     authtypes.NewQueryClient(conn),
+		simulate: simulate.NewSimulateServiceClient(conn),
+		accounts: make(map[string]*account),
+	}, nil
+}
+
+// Address returns the address of the key of the keyring
+func (c *Client) Address(from string) (sdk.AccAddress, error) {
+	info, err := c.keyring.Key(from)
+	if err != nil {
+		return nil, err
+	}
+	return info.GetAddress(), nil
+}
+
+// Broadcast signs the messages with the key from, broadcasts them in a single
+// transaction and waits for it to be included in a block. A transaction
+// rejected for its sequence or not reaching the node is retried.
+func (c *Client) Broadcast(ctx context.Context, from string, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	address, err := c.Address(from)
+	if err != nil {
+		return nil, err
+	}
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, err
+		}
+	}
+
+	// the account is only locked until the sequence is increased, the
+	// inclusion of the transaction is awaited without blocking the next ones
+	hash, err := c.broadcastWithRetries(ctx, c.account(address), from, address, msgs)
+	if err != nil {
+		return nil, err
+	}
+	return c.waitTx(ctx, hash)
+}
+
+// broadcastWithRetries broadcasts the transaction while holding the lock of
+// the account, a transaction rejected for its sequence or not reaching the
+// node is retried with the account state reloaded from the chain
+func (c *Client) broadcastWithRetries(ctx context.Context, acc *account, from string, address sdk.AccAddress, msgs []sdk.Msg) ([]byte, error) {
+	acc.Lock()
+	defer acc.Unlock()
+
+	for attempt := 0; ; attempt++ {
+		hash, err := c.broadcast(ctx, acc, from, address, msgs)
+		if err == nil {
+			return hash, nil
+		}
+		if attempt >= c.cfg.MaxRetries || !retryable(err) {
+			return nil, err
+		}
+		acc.loaded = false
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(c.cfg.RetryInterval):
+		}
+	}
+}
+
+// broadcast signs and broadcasts the transaction with the current sequence of
+// the account, the sequence is only increased once the transaction passed
+// the CheckTx of the node
+func (c *Client) broadcast(ctx context.Context, acc *account, from string, address sdk.AccAddress, msgs []sdk.Msg) ([]byte, error) {
+	if !acc.loaded {
+		if err := c.loadAccount(ctx, acc, address); err != nil {
+			return nil, err
+		}
+	}
+
+	txf := tx.Factory{}.
+		WithTxConfig(c.txConfig).
+		WithKeybase(c.keyring).
+		WithChainID(c.cfg.ChainID).
+		WithAccountNumber(acc.number).
+		WithSequence(acc.sequence).
+		WithGasAdjustment(c.cfg.GasAdjustment)
+
+	gas, err := c.estimateGas(ctx, txf, msgs)
+	if err != nil {
+		return nil, err
+	}
+	txf = txf.WithGas(gas).WithGasPrices(c.cfg.GasPrices)
+
+	builder, err := tx.BuildUnsignedTx(txf, msgs...)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Sign(txf, from, builder); err != nil {
+		return nil, err
+	}
+	txBytes, err := c.txConfig.TxEncoder()(builder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.rpc.BroadcastTxSync(txBytes)
+	if err != nil {
+		return nil, &transportError{err}
+	}
+	if res.Code != 0 {
+		return nil, sdkerrors.ABCIError(res.Codespace, res.Code, res.Log)
+	}
+
+	acc.sequence++
+	return res.Hash, nil
+}
+
+// estimateGas simulates the transaction and returns the adjusted gas used
+func (c *Client) estimateGas(ctx context.Context, txf tx.Factory, msgs []sdk.Msg) (uint64, error) {
+	bz, err := tx.BuildSimTx(txf, msgs...)
+	if err != nil {
+		return 0, err
+	}
+
+	var req simulate.SimulateRequest
+	if err := req.Unmarshal(bz); err != nil {
+		return 0, err
+	}
+	res, err := c.simulate.Simulate(ctx, &req)
+	if err != nil {
+		// the simulation errors only reach the client as gRPC status messages
+		if strings.Contains(err.Error(), sdkerrors.ErrWrongSequence.Error()) {
+			return 0, sdkerrors.Wrap(sdkerrors.ErrWrongSequence, err.Error())
+		}
+		return 0, err
+	}
+	return uint64(txf.GasAdjustment() * float64(res.GasInfo.GasUsed)), nil
+}
+
+// waitTx polls the node until the transaction is included in a block
+func (c *Client) waitTx(ctx context.Context, hash []byte) (*sdk.TxResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.cfg.Timeout)
+	defer cancel()
+
+	for {
+		if res, err := c.rpc.Tx(hash, false); err == nil {
+			txRes := &sdk.TxResponse{
+				Height:    res.Height,
+				TxHash:    strings.ToUpper(hex.EncodeToString(res.Hash)),
+				Codespace: res.TxResult.Codespace,
+				Code:      res.TxResult.Code,
+				Data:      strings.ToUpper(hex.EncodeToString(res.TxResult.Data)),
+				RawLog:    res.TxResult.Log,
+				GasWanted: res.TxResult.GasWanted,
+				GasUsed:   res.TxResult.GasUsed,
+			}
+			if txRes.Code != 0 {
+				return txRes, sdkerrors.ABCIError(txRes.Codespace, txRes.Code, txRes.RawLog)
+			}
+			return txRes, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("transaction %X not included in a block: %w", hash, ctx.Err())
+		case <-time.After(time.Second / 2):
+		}
+	}
+}
+
+func (c *Client) account(address sdk.AccAddress) *account {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	acc, ok := c.accounts[address.String()]
+	if !ok {
+		acc = &account{}
+		c.accounts[address.String()] = acc
+	}
+	return acc
+}
+
+// loadAccount queries the account number and sequence of the signer
+func (c *Client) loadAccount(ctx context.Context, acc *account, address sdk.AccAddress) error {
+	res, err := c.auth.Account(ctx, &authtypes.QueryAccountRequest{Address: address})
+	if err != nil {
+		return &transportError{err}
+	}
+
+	var accountI authtypes.AccountI
+	if err := c.registry.UnpackAny(res.Account, &accountI); err != nil {
+		return err
+	}
+	acc.number = accountI.GetAccountNumber()
+	acc.sequence = accountI.GetSequence()
+	acc.loaded = true
+	return nil
+}
+
+// decodeResponse decodes the Msg service response of the i-th message of the
+// transaction
+func decodeResponse(res *sdk.TxResponse, i int, msgRes proto.Message) error {
+	bz, err := hex.DecodeString(res.Data)
+	if err != nil {
+		return err
+	}
+
+	var data sdk.TxMsgData
+	if err := proto.Unmarshal(bz, &data); err != nil {
+		return err
+	}
+	if i >= len(data.Data) {
+		return fmt.Errorf("transaction %s has no response for message %d", res.TxHash, i)
+	}
+	return proto.Unmarshal(data.Data[i].Data, msgRes)
+}
+
+// transportError is an error reaching the node
+type transportError struct {
+	err error
+}
+
+func (e *transportError) Error() string { return e.err.Error() }
+func (e *transportError) Unwrap() error { return e.err }
+
+// retryable returns whether the broadcast failed for a reason that may be
+// gone on the next attempt
+func retryable(err error) bool {
+	var transport *transportError
+	return errors.As(err, &transport) ||
+		errors.Is(err, sdkerrors.ErrWrongSequence) ||
+		errors.Is(err, sdkerrors.ErrMempoolIsFull)
+}
@@ -0,0 +1,177 @@
+package nftclient_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/irismod/nft/app"
+	"github.com/irismod/nft/client/nftclient"
+	"github.com/irismod/nft/types"
+)
+
+const (
+	from      = "node0"
+	denomID   = "clientdenom"
+	denomName = "clientdenomname"
+	schema    = "{a:a,b:b}"
+	tokenURI  = "https://google.com/token-1.json"
+	tokenData = "{a:a,b:b}"
+)
+
+type ClientTestSuite struct {
+	suite.Suite
+
+	network *network.Network
+	conn    *grpc.ClientConn
+	client  *nftclient.Client
+}
+
+func (s *ClientTestSuite) SetupSuite() {
+	encCfg := simapp.MakeEncodingConfig()
+	cfg := network.DefaultConfig()
+	cfg.Codec = encCfg.Marshaler
+	cfg.TxConfig = encCfg.TxConfig
+	cfg.LegacyAmino = encCfg.Amino
+	cfg.InterfaceRegistry = encCfg.InterfaceRegistry
+	cfg.GenesisState = simapp.ModuleBasics.DefaultGenesis(encCfg.Marshaler)
+	cfg.NumValidators = 1
+	cfg.AppConstructor = func(val network.Validator) servertypes.Application {
+		return simapp.NewSimApp(
+			val.Ctx.Logger, dbm.NewMemDB(), nil, true, make(map[int64]bool), val.Ctx.Config.RootDir, 0,
+			encCfg, simapp.EmptyAppOptions{},
+			baseapp.SetPruning(storetypes.NewPruningOptionsFromString(val.AppConfig.Pruning)),
+			baseapp.SetMinGasPrices(val.AppConfig.MinGasPrices),
+		)
+	}
+
+	s.network = network.New(s.T(), cfg)
+	_, err := s.network.WaitForHeight(1)
+	s.Require().NoError(err)
+
+	val := s.network.Validators[0]
+	s.conn, err = grpc.Dial(val.AppConfig.GRPC.Address, grpc.WithInsecure())
+	s.Require().NoError(err)
+
+	s.client, err = nftclient.New(nftclient.Config{
+		ChainID:   cfg.ChainID,
+		NodeURI:   val.RPCAddress,
+		GasPrices: cfg.MinGasPrices,
+	}, s.conn, val.ClientCtx.Keyring)
+	s.Require().NoError(err)
+}
+
+func (s *ClientTestSuite) TearDownSuite() {
+	s.Require().NoError(s.conn.Close())
+	s.network.Cleanup()
+}
+
+func TestClientTestSuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping the in-process network with -short")
+	}
+	if err := checkTestKeyring(t); err != nil {
+		t.Skipf("skipping the in-process network, its test keyring is unusable: %s", err)
+	}
+	suite.Run(t, new(ClientTestSuite))
+}
+
+// checkTestKeyring creates a key in the file based keyring of the network, the
+// JOSE library it encrypts the keys with panics with the HMAC checks of go1.24+
+func checkTestKeyring(t *testing.T) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	kr, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, t.TempDir(), nil)
+	if err != nil {
+		return err
+	}
+	_, _, err = kr.NewMnemonic("check", keyring.English, sdk.FullFundraiserPath, hd.Secp256k1)
+	return err
+}
+
+func (s *ClientTestSuite) TestClient() {
+	ctx := context.Background()
+	owner := s.network.Validators[0].Address
+
+	issueRes, err := s.client.IssueDenom(ctx, from, denomID, denomName, schema, "", "", "", 0, false)
+	s.Require().NoError(err)
+	s.Require().Equal(denomID, issueRes.DenomId)
+
+	denom, err := s.client.GetDenom(ctx, denomID)
+	s.Require().NoError(err)
+	s.Require().Equal(denomName, denom.Name)
+	s.Require().Equal(owner, denom.Creator)
+
+	// the mints of a signer are sent concurrently, each one with its own sequence
+	var wg sync.WaitGroup
+	errs := make([]error, 3)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			var res *types.MsgMintNFTResponse
+			res, errs[i] = s.client.Mint(ctx, from, denomID, fmt.Sprintf("token%d", i), "", tokenURI, "", tokenData, nil, nil)
+			if errs[i] == nil && res.TokenId != fmt.Sprintf("token%d", i) {
+				errs[i] = fmt.Errorf("unexpected token id %s", res.TokenId)
+			}
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		s.Require().NoError(err)
+	}
+
+	nft, err := s.client.GetNFT(ctx, denomID, "token0")
+	s.Require().NoError(err)
+	s.Require().Equal(tokenURI, nft.URI)
+	s.Require().Equal(owner, nft.Owner)
+
+	supply, err := s.client.GetSupply(ctx, denomID, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(3), supply)
+
+	info, _, err := s.network.Validators[0].ClientCtx.Keyring.NewMnemonic("recipient", keyring.English, sdk.FullFundraiserPath, hd.Secp256k1)
+	s.Require().NoError(err)
+	recipient := info.GetAddress()
+
+	_, err = s.client.Transfer(ctx, from, denomID, "token1", recipient)
+	s.Require().NoError(err)
+
+	nft, err = s.client.GetNFT(ctx, denomID, "token1")
+	s.Require().NoError(err)
+	s.Require().Equal(recipient, nft.Owner)
+
+	idcs, err := s.client.ListOwnerNFTs(ctx, owner, denomID)
+	s.Require().NoError(err)
+	s.Require().Len(idcs, 1)
+	s.Require().ElementsMatch([]string{"token0", "token2"}, idcs[0].Ids)
+
+	_, err = s.client.Burn(ctx, from, denomID, "token0")
+	s.Require().NoError(err)
+	_, err = s.client.GetNFT(ctx, denomID, "token0")
+	s.Require().Error(err)
+
+	// a rejected transaction is reported and doesn't consume the sequence
+	_, err = s.client.Transfer(ctx, from, denomID, "token1", owner)
+	s.Require().Error(err)
+	_, err = s.client.Burn(ctx, from, denomID, "token2")
+	s.Require().NoError(err)
+}
@@ -0,0 +1,50 @@
+package nftclient
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irismod/nft/types"
+)
+
+// GetDenom returns the denom
+func (c *Client) GetDenom(ctx context.Context, denomID string) (types.Denom, error) {
+	res, err := c.query.Denom(ctx, &types.QueryDenomRequest{Denom: denomID})
+	if err != nil {
+		return types.Denom{}, err
+	}
+	return *res.Denom, nil
+}
+
+// GetNFT returns the NFT of the denom
+func (c *Client) GetNFT(ctx context.Context, denomID, tokenID string) (types.BaseNFT, error) {
+	res, err := c.query.NFT(ctx, &types.QueryNFTRequest{Denom: denomID, Id: tokenID})
+	if err != nil {
+		return types.BaseNFT{}, err
+	}
+	return *res.NFT, nil
+}
+
+// GetSupply returns the number of NFTs of the denom, or the number owned by
+// the owner when set
+func (c *Client) GetSupply(ctx context.Context, denomID string, owner sdk.AccAddress) (uint64, error) {
+	res, err := c.query.Supply(ctx, &types.QuerySupplyRequest{Denom: denomID, Owner: owner})
+	if err != nil {
+		return 0, err
+	}
+	return res.Amount, nil
+}
+
+// ListOwnerNFTs returns the ids of the NFTs owned by the owner grouped by
+// denom, an empty denomID lists the NFTs of every denom
+func (c *Client) ListOwnerNFTs(ctx context.Context, owner sdk.AccAddress, denomID string) (types.IDCollections, error) {
+	res, err := c.query.Owner(ctx, &types.QueryOwnerRequest{Denom: denomID, Owner: owner})
+	if err != nil {
+		return nil, err
+	}
+	if res.Owner == nil {
+		return nil, nil
+	}
+	return res.Owner.IDCollections, nil
+}
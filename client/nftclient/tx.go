@@ -0,0 +1,109 @@
+package nftclient
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irismod/nft/types"
+)
+
+// IssueDenom issues the denom with the key from as its creator
+func (c *Client) IssueDenom(ctx context.Context, from string,
+	denomID, name, schema, uri, uriHash, baseURI string,
+	historyRetention uint64, revocable bool) (*types.MsgIssueDenomResponse, error) {
+	sender, err := c.Address(from)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgIssueDenom(denomID, name, schema, uri, uriHash, baseURI, historyRetention, revocable, sender.String())
+	res, err := c.Broadcast(ctx, from, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	var msgRes types.MsgIssueDenomResponse
+	return &msgRes, decodeResponse(res, 0, &msgRes)
+}
+
+// Mint mints the NFT with the key from and sets its owner to the recipient,
+// an empty recipient mints to the sender
+func (c *Client) Mint(ctx context.Context, from string,
+	denomID, tokenID, name, uri, uriHash, data string,
+	attributes []types.Attribute, recipient sdk.AccAddress) (*types.MsgMintNFTResponse, error) {
+	sender, err := c.Address(from)
+	if err != nil {
+		return nil, err
+	}
+	if recipient.Empty() {
+		recipient = sender
+	}
+
+	msg := types.NewMsgMintNFT(tokenID, denomID, name, uri, uriHash, data, attributes, sender.String(), recipient.String())
+	res, err := c.Broadcast(ctx, from, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	var msgRes types.MsgMintNFTResponse
+	return &msgRes, decodeResponse(res, 0, &msgRes)
+}
+
+// Edit edits the NFT owned by the key from, the fields set to
+// types.DoNotModify and empty attributes are left as is
+func (c *Client) Edit(ctx context.Context, from string,
+	denomID, tokenID, name, uri, uriHash, data string,
+	attributes []types.Attribute) (*types.MsgEditNFTResponse, error) {
+	sender, err := c.Address(from)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgEditNFT(tokenID, denomID, name, uri, uriHash, data, attributes, sender.String())
+	res, err := c.Broadcast(ctx, from, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	var msgRes types.MsgEditNFTResponse
+	return &msgRes, decodeResponse(res, 0, &msgRes)
+}
+
+// Transfer transfers the NFT owned by the key from to the recipient without
+// modifying it
+func (c *Client) Transfer(ctx context.Context, from string,
+	denomID, tokenID string, recipient sdk.AccAddress) (*types.MsgTransferNFTResponse, error) {
+	sender, err := c.Address(from)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgTransferNFT(tokenID, denomID,
+		types.DoNotModify, types.DoNotModify, types.DoNotModify, types.DoNotModify,
+		sender.String(), recipient.String())
+	res, err := c.Broadcast(ctx, from, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	var msgRes types.MsgTransferNFTResponse
+	return &msgRes, decodeResponse(res, 0, &msgRes)
+}
+
+// Burn burns the NFT owned by the key from
+func (c *Client) Burn(ctx context.Context, from string, denomID, tokenID string) (*types.MsgBurnNFTResponse, error) {
+	sender, err := c.Address(from)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgBurnNFT(sender.String(), tokenID, denomID)
+	res, err := c.Broadcast(ctx, from, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	var msgRes types.MsgBurnNFTResponse
+	return &msgRes, decodeResponse(res, 0, &msgRes)
+}
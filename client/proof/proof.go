@@ -0,0 +1,142 @@
+// Package proof proves that an address owns a NFT at a height without trusting
+// the node serving the proof. An OwnershipProof holds the stored BaseNFT with
+// the ICS-23 merkle proofs of its key and of the owner index key of the
+// module store, from the IAVL root of the store up to the app hash. The app
+// hash of a height is committed in the header of the next block, it has to
+// come from a trusted source such as a light client.
+package proof
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/tendermint/tendermint/crypto/merkle"
+	tmcrypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irismod/nft/types"
+)
+
+// OwnershipProof proves the owner of a NFT in the state of a height
+type OwnershipProof struct {
+	// Height is the height of the state, its app hash is the one of the
+	// header of the block Height+1
+	Height  int64  `json:"height"`
+	DenomID string `json:"denom_id"`
+	TokenID string `json:"token_id"`
+	// NFT is the proto encoded BaseNFT as stored under types.KeyNFT
+	NFT []byte `json:"nft"`
+	// NFTProof proves NFT under types.KeyNFT
+	NFTProof *tmcrypto.ProofOps `json:"nft_proof"`
+	// OwnerProof proves the owner index entry of the owner of NFT under
+	// types.KeyOwner
+	OwnerProof *tmcrypto.ProofOps `json:"owner_proof"`
+}
+
+// Query returns the ownership proof of the NFT in the state of the height, or
+// of the latest height when zero. The node must keep the state of the height,
+// the proofs of the first height are not served.
+func Query(node rpcclient.ABCIClient, denomID, tokenID string, height int64) (*OwnershipProof, error) {
+	denomID = strings.ToLower(strings.TrimSpace(denomID))
+	tokenID = strings.ToLower(strings.TrimSpace(tokenID))
+
+	value, nftProof, height, err := queryKey(node, types.KeyNFT(denomID, tokenID), height)
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 0 {
+		return nil, fmt.Errorf("%w: %s/%s at height %d", types.ErrUnknownNFT, denomID, tokenID, height)
+	}
+
+	var nft types.BaseNFT
+	if err := nft.Unmarshal(value); err != nil {
+		return nil, fmt.Errorf("failed to decode the NFT %s/%s: %w", denomID, tokenID, err)
+	}
+
+	// the owner index is queried at the height of the NFT
+	_, ownerProof, _, err := queryKey(node, types.KeyOwner(nft.Owner, denomID, tokenID), height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OwnershipProof{
+		Height:     height,
+		DenomID:    denomID,
+		TokenID:    tokenID,
+		NFT:        value,
+		NFTProof:   nftProof,
+		OwnerProof: ownerProof,
+	}, nil
+}
+
+// queryKey returns the value of the key of the module store with its proof
+// and the height of the state it was read from
+func queryKey(node rpcclient.ABCIClient, key []byte, height int64) ([]byte, *tmcrypto.ProofOps, int64, error) {
+	path := fmt.Sprintf("/store/%s/key", types.StoreKey)
+	res, err := node.ABCIQueryWithOptions(path, key, rpcclient.ABCIQueryOptions{Height: height, Prove: true})
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	if !res.Response.IsOK() {
+		return nil, nil, 0, errors.New(res.Response.Log)
+	}
+	if res.Response.ProofOps == nil || len(res.Response.ProofOps.Ops) == 0 {
+		return nil, nil, 0, fmt.Errorf("the node returned no proof for the key %X", key)
+	}
+	return res.Response.Value, res.Response.ProofOps, res.Response.Height, nil
+}
+
+// Verify checks the proofs against the app hash and returns the proven NFT
+func (p OwnershipProof) Verify(appHash []byte) (types.BaseNFT, error) {
+	var nft types.BaseNFT
+	if p.NFTProof == nil || p.OwnerProof == nil {
+		return nft, errors.New("missing proof")
+	}
+	if err := nft.Unmarshal(p.NFT); err != nil {
+		return nft, fmt.Errorf("failed to decode the NFT: %w", err)
+	}
+	if nft.Id != p.TokenID {
+		return nft, fmt.Errorf("the proven NFT is %s, not %s", nft.Id, p.TokenID)
+	}
+
+	prt := rootmulti.DefaultProofRuntime()
+	if err := prt.VerifyValue(p.NFTProof, appHash, keyPath(types.KeyNFT(p.DenomID, p.TokenID)), p.NFT); err != nil {
+		return nft, fmt.Errorf("invalid NFT proof: %w", err)
+	}
+
+	ownerValue, err := (&gogotypes.StringValue{Value: p.TokenID}).Marshal()
+	if err != nil {
+		return nft, err
+	}
+	if err := prt.VerifyValue(p.OwnerProof, appHash, keyPath(types.KeyOwner(nft.Owner, p.DenomID, p.TokenID)), ownerValue); err != nil {
+		return nft, fmt.Errorf("invalid owner proof: %w", err)
+	}
+	return nft, nil
+}
+
+// VerifyOwner checks the proofs against the app hash and that the owner of the
+// proven NFT is the address
+func (p OwnershipProof) VerifyOwner(appHash []byte, owner sdk.AccAddress) error {
+	nft, err := p.Verify(appHash)
+	if err != nil {
+		return err
+	}
+	if !nft.Owner.Equals(owner) {
+		return fmt.Errorf("%s/%s is owned by %s, not %s", p.DenomID, p.TokenID, nft.Owner, owner)
+	}
+	return nil
+}
+
+// keyPath returns the path of the key of the module store in the app hash
+func keyPath(key []byte) string {
+	return merkle.KeyPath{}.
+		AppendKey([]byte(types.StoreKey), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingHex).
+		String()
+}
@@ -0,0 +1,103 @@
+package proof_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/bytes"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/irismod/nft/app"
+	"github.com/irismod/nft/client/proof"
+	"github.com/irismod/nft/types"
+)
+
+var (
+	alice = sdk.AccAddress("alice_______________")
+	bob   = sdk.AccAddress("bob_________________")
+)
+
+// fakeNode serves the ABCI queries from the app, the other methods are left to
+// the nil embedded interface
+type fakeNode struct {
+	rpcclient.ABCIClient
+
+	app *simapp.SimApp
+}
+
+func (n fakeNode) ABCIQueryWithOptions(path string, data bytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*ctypes.ResultABCIQuery, error) {
+	res := n.app.Query(abci.RequestQuery{Path: path, Data: data, Height: opts.Height, Prove: opts.Prove})
+	return &ctypes.ResultABCIQuery{Response: res}, nil
+}
+
+// setupApp mints kitty1 to alice and commits two blocks, the proofs of the
+// first height are not served
+func setupApp(t *testing.T) *simapp.SimApp {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	require.NoError(t, app.NFTKeeper.IssueDenom(ctx, "kitty", "kitties", "", "", "", "", 0, false, alice))
+	require.NoError(t, app.NFTKeeper.MintNFT(ctx, "kitty", "kitty1", "Tom", "ipfs://tom", "", "", nil, alice, alice))
+	app.Commit()
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 2}})
+	app.Commit()
+	return app
+}
+
+func TestOwnershipProof(t *testing.T) {
+	app := setupApp(t)
+	appHash := app.LastCommitID().Hash
+
+	p, err := proof.Query(fakeNode{app: app}, "Kitty", "kitty1", 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), p.Height)
+
+	nft, err := p.Verify(appHash)
+	require.NoError(t, err)
+	require.Equal(t, "Tom", nft.Name)
+	require.NoError(t, p.VerifyOwner(appHash, alice))
+	require.Error(t, p.VerifyOwner(appHash, bob))
+
+	// the proof is checked offline from its JSON encoding
+	bz, err := json.Marshal(p)
+	require.NoError(t, err)
+	var decoded proof.OwnershipProof
+	require.NoError(t, json.Unmarshal(bz, &decoded))
+	require.NoError(t, decoded.VerifyOwner(appHash, alice))
+
+	// another app hash is rejected
+	_, err = p.Verify(make([]byte, len(appHash)))
+	require.Error(t, err)
+
+	// and so is a forged NFT
+	nft.Owner = bob
+	forged := *p
+	forged.NFT, err = nft.Marshal()
+	require.NoError(t, err)
+	require.Error(t, forged.VerifyOwner(appHash, bob))
+
+	// as well as a proof of another token
+	forged = *p
+	forged.TokenID = "kitty2"
+	_, err = forged.Verify(appHash)
+	require.Error(t, err)
+}
+
+func TestQueryUnknownNFT(t *testing.T) {
+	app := setupApp(t)
+
+	_, err := proof.Query(fakeNode{app: app}, "kitty", "kitty2", 0)
+	require.True(t, errors.Is(err, types.ErrUnknownNFT))
+
+	// the first height has no proof
+	_, err = proof.Query(fakeNode{app: app}, "kitty", "kitty1", 1)
+	require.Error(t, err)
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/irismod/nft/types"
 )
 
 // RegisterHandlers register distribution REST routes.
@@ -26,25 +28,38 @@ type issueDenomReq struct {
 	ID      string         `json:"id"`
 	Name    string         `json:"name"`
 	Schema  string         `json:"schema"`
+	URI     string         `json:"uri"`
+	URIHash string         `json:"uri_hash"`
+	BaseURI string         `json:"base_uri"`
+
+	HistoryRetention uint64 `json:"history_retention"`
+	Revocable        bool   `json:"revocable"`
 }
 
 type mintNFTReq struct {
-	BaseReq   rest.BaseReq   `json:"base_req"`
-	Owner     sdk.AccAddress `json:"owner"`
-	Recipient sdk.AccAddress `json:"recipient"`
-	Denom     string         `json:"denom"`
-	ID        string         `json:"id"`
-	Name      string         `json:"name"`
-	URI       string         `json:"uri"`
-	Data      string         `json:"data"`
+	BaseReq    rest.BaseReq      `json:"base_req"`
+	Owner      sdk.AccAddress    `json:"owner"`
+	Recipient  sdk.AccAddress    `json:"recipient"`
+	Denom      string            `json:"denom"`
+	ID         string            `json:"id"`
+	Name       string            `json:"name"`
+	URI        string            `json:"uri"`
+	URIHash    string            `json:"uri_hash"`
+	Data       string            `json:"data"`
+	Attributes []types.Attribute `json:"attributes"`
 }
 
 type editNFTReq struct {
-	BaseReq rest.BaseReq   `json:"base_req"`
-	Owner   sdk.AccAddress `json:"owner"`
-	Name    string         `json:"name"`
-	URI     string         `json:"uri"`
-	Data    string         `json:"data"`
+	BaseReq    rest.BaseReq      `json:"base_req"`
+	Owner      sdk.AccAddress    `json:"owner"`
+	Name       string            `json:"name"`
+	URI        string            `json:"uri"`
+	URIHash    string            `json:"uri_hash"`
+	Data       string            `json:"data"`
+	Attributes []types.Attribute `json:"attributes"`
+	// ClearAttributes removes every attribute, the attributes are kept when
+	// left out
+	ClearAttributes bool `json:"clear_attributes"`
 }
 
 type transferNFTReq struct {
@@ -53,6 +68,7 @@ type transferNFTReq struct {
 	Recipient string         `json:"recipient"`
 	Name      string         `json:"name"`
 	URI       string         `json:"uri"`
+	URIHash   string         `json:"uri_hash"`
 	Data      string         `json:"data"`
 }
 
@@ -59,7 +59,7 @@ func issueDenomHandlerFn(cliCtx client.Context) http.HandlerFunc {
 		}
 
 		// create the message
-		msg := types.NewMsgIssueDenom(req.ID, req.Name, req.Schema, req.Owner)
+		msg := types.NewMsgIssueDenom(req.ID, req.Name, req.Schema, req.URI, req.URIHash, req.BaseURI, req.HistoryRetention, req.Revocable, req.Owner.String())
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -89,9 +89,11 @@ func mintNFTHandlerFn(cliCtx client.Context) http.HandlerFunc {
 			req.Denom,
 			req.Name,
 			req.URI,
+			req.URIHash,
 			req.Data,
-			req.Owner,
-			req.Recipient,
+			req.Attributes,
+			req.Owner.String(),
+			req.Recipient.String(),
 		)
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -120,8 +122,12 @@ func editNFTHandlerFn(cliCtx client.Context) http.HandlerFunc {
 			vars[RestParamDenom],
 			req.Name,
 			req.URI,
-			req.Data, req.Owner,
+			req.URIHash,
+			req.Data,
+			req.Attributes,
+			req.Owner.String(),
 		)
+		msg.ClearAttributes = req.ClearAttributes
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -154,9 +160,10 @@ func transferNFTHandlerFn(cliCtx client.Context) http.HandlerFunc {
 			vars[RestParamDenom],
 			req.Name,
 			req.URI,
+			req.URIHash,
 			req.Data,
-			req.Owner,
-			recipient,
+			req.Owner.String(),
+			recipient.String(),
 		)
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -182,7 +189,7 @@ func burnNFTHandlerFn(cliCtx client.Context) http.HandlerFunc {
 
 		// create the message
 		msg := types.NewMsgBurnNFT(
-			req.Owner,
+			req.Owner.String(),
 			vars[RestParamTokenID],
 			vars[RestParamDenom],
 		)
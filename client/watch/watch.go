@@ -0,0 +1,356 @@
+// Package watch streams the typed events of the NFT module from a node. It
+// subscribes to the new blocks over the Tendermint websocket, decodes the
+// events of their transactions and reconnects and resumes from the last
+// processed height when the connection is lost.
+package watch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/irismod/nft/types"
+)
+
+const (
+	// DefaultReconnectInterval is the delay before reconnecting to the node
+	DefaultReconnectInterval = 2 * time.Second
+	// DefaultStallTimeout is how long the node may go without a new block
+	// before the connection is considered lost
+	DefaultStallTimeout = time.Minute
+
+	subscriber = "nft-watch"
+)
+
+// eventTypes are the event types of the module reported by the typed events
+var eventTypes = map[string]bool{
+	types.EventTypeIssueDenom: true,
+	types.EventTypeMintNFT:    true,
+	types.EventTypeTransfer:   true,
+	types.EventTypeEditNFT:    true,
+	types.EventTypeBurnNFT:    true,
+	types.EventTypeRevokeNFT:  true,
+	types.EventTypePause:      true,
+	types.EventTypeUnpause:    true,
+
+	types.EventTypeForceBurnNFT:         true,
+	types.EventTypeReassignDenomCreator: true,
+}
+
+// Event is a typed event of the module with the block and the transaction it
+// was emitted in, the transaction hash is empty for the events of the begin
+// and end blocks
+type Event struct {
+	Height int64
+	TxHash string
+	Type   string
+	Event  proto.Message
+}
+
+// MarshalJSON encodes the event with its typed event as proto JSON
+func (e Event) MarshalJSON() ([]byte, error) {
+	bz, err := codec.ProtoMarshalJSON(e.Event)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(struct {
+		Height int64           `json:"height"`
+		TxHash string          `json:"tx_hash,omitempty"`
+		Type   string          `json:"type"`
+		Event  json.RawMessage `json:"event"`
+	}{e.Height, e.TxHash, e.Type, bz})
+}
+
+// DenomID returns the denom of the event
+func (e Event) DenomID() string {
+	switch event := e.Event.(type) {
+	case *types.EventIssueDenom:
+		return event.DenomId
+	case *types.EventMint:
+		return event.DenomId
+	case *types.EventTransfer:
+		return event.DenomId
+	case *types.EventEdit:
+		return event.DenomId
+	case *types.EventBurn:
+		return event.DenomId
+	case *types.EventRevoke:
+		return event.DenomId
+	case *types.EventPauseDenom:
+		return event.DenomId
+	case *types.EventReassignDenomCreator:
+		return event.DenomId
+	}
+	return ""
+}
+
+// EventType returns the event type of the module the typed event is emitted
+// with, the typed events shared by two event types are told apart by their
+// fields
+func (e Event) EventType() string {
+	switch event := e.Event.(type) {
+	case *types.EventIssueDenom:
+		return types.EventTypeIssueDenom
+	case *types.EventMint:
+		return types.EventTypeMintNFT
+	case *types.EventTransfer:
+		return types.EventTypeTransfer
+	case *types.EventEdit:
+		return types.EventTypeEditNFT
+	case *types.EventBurn:
+		if event.Forced {
+			return types.EventTypeForceBurnNFT
+		}
+		return types.EventTypeBurnNFT
+	case *types.EventRevoke:
+		return types.EventTypeRevokeNFT
+	case *types.EventPauseDenom:
+		if event.Paused {
+			return types.EventTypePause
+		}
+		return types.EventTypeUnpause
+	case *types.EventReassignDenomCreator:
+		return types.EventTypeReassignDenomCreator
+	}
+	return ""
+}
+
+// Config defines where the stream starts and which events it reports
+type Config struct {
+	// FromHeight is the first height streamed, zero starts at the next block
+	FromHeight int64
+	// Denom only streams the events of the denom when set
+	Denom string
+	// EventTypes only streams the events of these types when set, e.g.
+	// types.EventTypeTransfer
+	EventTypes []string
+
+	ReconnectInterval time.Duration
+	StallTimeout      time.Duration
+}
+
+// Dialer creates the client of the node, it is called on every connection
+type Dialer func() (rpcclient.Client, error)
+
+// Handler is called on every event in the order of the chain, an error stops
+// the stream
+type Handler func(Event) error
+
+// Block holds the events of a block matching the configuration
+type Block struct {
+	Height int64
+	Events []Event
+}
+
+// BlockHandler is called on every block in the order of the chain, including
+// the ones without any event, an error stops the stream
+type BlockHandler func(Block) error
+
+// watcher holds the state of a stream across the connections
+type watcher struct {
+	cfg     Config
+	dial    Dialer
+	handle  BlockHandler
+	allowed map[string]bool
+
+	// next is the next height to process, zero until the first connection
+	// when the stream starts at the next block
+	next int64
+}
+
+// fatalError is an error which stops the stream instead of reconnecting
+type fatalError struct {
+	err error
+}
+
+func (e *fatalError) Error() string { return e.err.Error() }
+func (e *fatalError) Unwrap() error { return e.err }
+
+var errStalled = errors.New("no new block received")
+
+// Watch streams the events to the handler until the context is done or the
+// handler fails, the lost connections are reestablished and the stream
+// resumes from the first height not fully handled
+func Watch(ctx context.Context, dial Dialer, cfg Config, handle Handler) error {
+	return WatchBlocks(ctx, dial, cfg, func(block Block) error {
+		for _, event := range block.Events {
+			if err := handle(event); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// WatchBlocks streams the blocks to the handler like Watch, a block is handled
+// at most once and is handled again after a reconnection only if it failed
+func WatchBlocks(ctx context.Context, dial Dialer, cfg Config, handle BlockHandler) error {
+	if cfg.ReconnectInterval <= 0 {
+		cfg.ReconnectInterval = DefaultReconnectInterval
+	}
+	if cfg.StallTimeout <= 0 {
+		cfg.StallTimeout = DefaultStallTimeout
+	}
+
+	w := &watcher{cfg: cfg, dial: dial, handle: handle, next: cfg.FromHeight}
+	if len(cfg.EventTypes) > 0 {
+		w.allowed = make(map[string]bool)
+		for _, eventType := range cfg.EventTypes {
+			if !eventTypes[eventType] {
+				return fmt.Errorf("unknown event type %s", eventType)
+			}
+			w.allowed[eventType] = true
+		}
+	}
+
+	for {
+		err := w.run(ctx)
+		var fatal *fatalError
+		switch {
+		case ctx.Err() != nil:
+			return ctx.Err()
+		case errors.As(err, &fatal):
+			return fatal.err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(cfg.ReconnectInterval):
+		}
+	}
+}
+
+// run connects to the node, catches up with the blocks committed since the
+// last connection and streams the new ones until the connection is lost
+func (w *watcher) run(ctx context.Context) error {
+	node, err := w.dial()
+	if err != nil {
+		return err
+	}
+	if !node.IsRunning() {
+		if err := node.Start(); err != nil {
+			return err
+		}
+	}
+	defer node.Stop() // nolint: errcheck
+
+	blocks, err := node.Subscribe(ctx, subscriber, tmtypes.EventQueryNewBlockHeader.String())
+	if err != nil {
+		return err
+	}
+	defer node.UnsubscribeAll(context.Background(), subscriber) // nolint: errcheck
+
+	status, err := node.Status()
+	if err != nil {
+		return err
+	}
+	if err := w.processUpTo(node, status.SyncInfo.LatestBlockHeight); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(w.cfg.StallTimeout):
+			return errStalled
+		case event, ok := <-blocks:
+			if !ok {
+				return errors.New("subscription closed")
+			}
+			header, ok := event.Data.(tmtypes.EventDataNewBlockHeader)
+			if !ok {
+				continue
+			}
+			if err := w.processUpTo(node, header.Header.Height); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// processUpTo handles the blocks from the next height up to the height
+func (w *watcher) processUpTo(node rpcclient.Client, height int64) error {
+	if w.next == 0 {
+		w.next = height + 1
+	}
+
+	for ; w.next <= height; w.next++ {
+		events, err := w.blockEvents(node, w.next)
+		if err != nil {
+			return err
+		}
+		if err := w.handle(Block{Height: w.next, Events: events}); err != nil {
+			return &fatalError{err}
+		}
+	}
+	return nil
+}
+
+// blockEvents returns the events of the block matching the configuration, the
+// events of the failed transactions are left out
+func (w *watcher) blockEvents(node rpcclient.Client, height int64) ([]Event, error) {
+	results, err := node.BlockResults(&height)
+	if err != nil {
+		return nil, err
+	}
+
+	var block *tmtypes.Block
+	if len(results.TxsResults) > 0 {
+		res, err := node.Block(&height)
+		if err != nil {
+			return nil, err
+		}
+		block = res.Block
+	}
+
+	var events []Event
+	appendEvents := func(txHash string, abciEvents []abci.Event) error {
+		msgs, err := types.ParseTypedEvents(abciEvents)
+		if err != nil {
+			return &fatalError{fmt.Errorf("height %d: %w", height, err)}
+		}
+		for _, msg := range msgs {
+			event := Event{Height: height, TxHash: txHash, Type: proto.MessageName(msg), Event: msg}
+			if w.allowed != nil && !w.allowed[event.EventType()] {
+				continue
+			}
+			if len(w.cfg.Denom) > 0 && event.DenomID() != w.cfg.Denom {
+				continue
+			}
+			events = append(events, event)
+		}
+		return nil
+	}
+
+	if err := appendEvents("", results.BeginBlockEvents); err != nil {
+		return nil, err
+	}
+	for i, txResult := range results.TxsResults {
+		if txResult.Code != abci.CodeTypeOK {
+			continue
+		}
+		if i >= len(block.Txs) {
+			return nil, fmt.Errorf("height %d: missing transaction %d", height, i)
+		}
+		if err := appendEvents(fmt.Sprintf("%X", block.Txs[i].Hash()), txResult.Events); err != nil {
+			return nil, err
+		}
+	}
+	if err := appendEvents("", results.EndBlockEvents); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
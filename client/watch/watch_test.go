@@ -0,0 +1,261 @@
+package watch_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/irismod/nft/client/watch"
+	"github.com/irismod/nft/types"
+)
+
+// fakeNode serves the blocks of a chain, the client methods not used by the
+// watcher are left to the nil embedded interface
+type fakeNode struct {
+	rpcclient.Client
+
+	mu      sync.Mutex
+	blocks  []block
+	headers chan ctypes.ResultEvent
+	running bool
+
+	// afterStatus is called once the latest height is returned by Status
+	afterStatus func()
+}
+
+type block struct {
+	txs     []tmtypes.Tx
+	results *ctypes.ResultBlockResults
+}
+
+func newFakeNode() *fakeNode {
+	return &fakeNode{headers: make(chan ctypes.ResultEvent, 16)}
+}
+
+// commit appends a block holding a transaction per list of events, a nil list
+// is a failed transaction, and notifies the subscribers when notify is set
+func (n *fakeNode) commit(t *testing.T, notify bool, txs ...[]proto.Message) {
+	n.mu.Lock()
+	height := int64(len(n.blocks) + 1)
+	b := block{results: &ctypes.ResultBlockResults{Height: height}}
+	for i, msgs := range txs {
+		b.txs = append(b.txs, tmtypes.Tx(fmt.Sprintf("tx-%d-%d", height, i)))
+
+		txResult := &abci.ResponseDeliverTx{}
+		if msgs == nil {
+			txResult.Code = 1
+			msgs = []proto.Message{&types.EventMint{DenomId: "failed"}}
+		}
+		for _, msg := range msgs {
+			event, err := types.TypedEventToEvent(msg)
+			require.NoError(t, err)
+			txResult.Events = append(txResult.Events, abci.Event(event))
+		}
+		b.results.TxsResults = append(b.results.TxsResults, txResult)
+	}
+	n.blocks = append(n.blocks, b)
+	n.mu.Unlock()
+
+	if notify {
+		n.headers <- ctypes.ResultEvent{Data: tmtypes.EventDataNewBlockHeader{Header: tmtypes.Header{Height: height}}}
+	}
+}
+
+func (n *fakeNode) Start() error {
+	n.running = true
+	return nil
+}
+
+func (n *fakeNode) Stop() error {
+	n.running = false
+	return nil
+}
+
+func (n *fakeNode) IsRunning() bool { return n.running }
+
+func (n *fakeNode) Subscribe(context.Context, string, string, ...int) (<-chan ctypes.ResultEvent, error) {
+	return n.headers, nil
+}
+
+func (n *fakeNode) UnsubscribeAll(context.Context, string) error { return nil }
+
+func (n *fakeNode) Status() (*ctypes.ResultStatus, error) {
+	n.mu.Lock()
+	status := &ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{LatestBlockHeight: int64(len(n.blocks))}}
+	n.mu.Unlock()
+
+	if n.afterStatus != nil {
+		n.afterStatus()
+	}
+	return status, nil
+}
+
+func (n *fakeNode) Block(height *int64) (*ctypes.ResultBlock, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return &ctypes.ResultBlock{Block: &tmtypes.Block{Data: tmtypes.Data{Txs: n.blocks[*height-1].txs}}}, nil
+}
+
+func (n *fakeNode) BlockResults(height *int64) (*ctypes.ResultBlockResults, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.blocks[*height-1].results, nil
+}
+
+// collect streams the events until count of them are received
+func collect(t *testing.T, dial watch.Dialer, cfg watch.Config, count int, onEvent func(watch.Event)) []watch.Event {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var events []watch.Event
+	done := errors.New("done")
+	err := watch.Watch(ctx, dial, cfg, func(event watch.Event) error {
+		events = append(events, event)
+		if onEvent != nil {
+			onEvent(event)
+		}
+		if len(events) == count {
+			return done
+		}
+		return nil
+	})
+	require.Equal(t, done, err)
+	return events
+}
+
+func TestWatch(t *testing.T) {
+	node := newFakeNode()
+	node.commit(t, false, []proto.Message{&types.EventIssueDenom{DenomId: "kitty", Creator: "alice"}})
+	node.commit(t, false,
+		nil,
+		[]proto.Message{&types.EventMint{DenomId: "kitty", TokenId: "k1", Recipient: "alice"}},
+		[]proto.Message{&types.EventMint{DenomId: "puppy", TokenId: "p1", Recipient: "alice"}},
+	)
+
+	dial := func() (rpcclient.Client, error) { return node, nil }
+	events := collect(t, dial, watch.Config{FromHeight: 1, Denom: "kitty"}, 3, func(event watch.Event) {
+		if event.Height == 2 {
+			node.commit(t, true, []proto.Message{&types.EventTransfer{DenomId: "kitty", TokenId: "k1", Sender: "alice", Recipient: "bob"}})
+		}
+	})
+
+	require.Equal(t, int64(1), events[0].Height)
+	require.Equal(t, &types.EventIssueDenom{DenomId: "kitty", Creator: "alice"}, events[0].Event)
+	require.Equal(t, int64(2), events[1].Height)
+	require.Equal(t, fmt.Sprintf("%X", tmtypes.Tx("tx-2-1").Hash()), events[1].TxHash)
+	require.Equal(t, "k1", events[1].Event.(*types.EventMint).TokenId)
+	require.Equal(t, int64(3), events[2].Height)
+	require.Equal(t, "irismod.nft.EventTransfer", events[2].Type)
+	require.Equal(t, "bob", events[2].Event.(*types.EventTransfer).Recipient)
+
+	bz, err := events[2].MarshalJSON()
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"height":3`)
+	require.Contains(t, string(bz), `"recipient":"bob"`)
+}
+
+func TestWatchEventTypes(t *testing.T) {
+	node := newFakeNode()
+	node.commit(t, false, []proto.Message{
+		&types.EventMint{DenomId: "kitty", TokenId: "k1"},
+		&types.EventTransfer{DenomId: "kitty", TokenId: "k1"},
+	})
+
+	dial := func() (rpcclient.Client, error) { return node, nil }
+	events := collect(t, dial, watch.Config{FromHeight: 1, EventTypes: []string{types.EventTypeTransfer}}, 1, nil)
+	require.IsType(t, &types.EventTransfer{}, events[0].Event)
+
+	err := watch.Watch(context.Background(), dial, watch.Config{EventTypes: []string{"unknown"}}, nil)
+	require.Error(t, err)
+}
+
+func TestWatchSharedTypedEvents(t *testing.T) {
+	node := newFakeNode()
+	node.commit(t, false, []proto.Message{
+		&types.EventPauseDenom{DenomId: "kitty", Paused: true},
+		&types.EventBurn{DenomId: "kitty", TokenId: "k1"},
+		&types.EventPauseDenom{DenomId: "kitty", Paused: false},
+		&types.EventBurn{DenomId: "kitty", TokenId: "k2", Forced: true},
+	})
+
+	// the event types sharing a typed event are filtered on its fields
+	dial := func() (rpcclient.Client, error) { return node, nil }
+	events := collect(t, dial, watch.Config{FromHeight: 1, EventTypes: []string{types.EventTypeUnpause, types.EventTypeForceBurnNFT}}, 2, nil)
+	require.Equal(t, types.EventTypeUnpause, events[0].EventType())
+	require.Equal(t, &types.EventBurn{DenomId: "kitty", TokenId: "k2", Forced: true}, events[1].Event)
+}
+
+func TestWatchReconnect(t *testing.T) {
+	node := newFakeNode()
+	node.commit(t, false, []proto.Message{&types.EventMint{DenomId: "kitty", TokenId: "k1"}})
+
+	// the first connection stalls, the blocks committed in the meantime are
+	// caught up on the next one
+	dials := 0
+	dial := func() (rpcclient.Client, error) {
+		dials++
+		switch dials {
+		case 2:
+			return nil, errors.New("connection refused")
+		case 3:
+			node.commit(t, false, []proto.Message{&types.EventMint{DenomId: "kitty", TokenId: "k2"}})
+		}
+		return node, nil
+	}
+
+	cfg := watch.Config{FromHeight: 1, ReconnectInterval: time.Millisecond, StallTimeout: 50 * time.Millisecond}
+	events := collect(t, dial, cfg, 2, nil)
+	require.Equal(t, 3, dials)
+	require.Equal(t, "k1", events[0].Event.(*types.EventMint).TokenId)
+	require.Equal(t, "k2", events[1].Event.(*types.EventMint).TokenId)
+}
+
+func TestWatchFromLatest(t *testing.T) {
+	node := newFakeNode()
+	node.commit(t, false, []proto.Message{&types.EventMint{DenomId: "kitty", TokenId: "k1"}})
+
+	node.afterStatus = func() {
+		node.commit(t, true, []proto.Message{&types.EventMint{DenomId: "kitty", TokenId: "k2"}})
+	}
+
+	dial := func() (rpcclient.Client, error) { return node, nil }
+	events := collect(t, dial, watch.Config{}, 1, nil)
+	require.Equal(t, "k2", events[0].Event.(*types.EventMint).TokenId)
+}
+
+func TestWatchBlocks(t *testing.T) {
+	node := newFakeNode()
+	node.commit(t, false)
+	node.commit(t, false, []proto.Message{&types.EventMint{DenomId: "kitty", TokenId: "k1"}})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// the blocks without any event are handled too
+	var blocks []watch.Block
+	done := errors.New("done")
+	dial := func() (rpcclient.Client, error) { return node, nil }
+	err := watch.WatchBlocks(ctx, dial, watch.Config{FromHeight: 1}, func(block watch.Block) error {
+		blocks = append(blocks, block)
+		if len(blocks) == 2 {
+			return done
+		}
+		return nil
+	})
+	require.Equal(t, done, err)
+	require.Equal(t, int64(1), blocks[0].Height)
+	require.Empty(t, blocks[0].Events)
+	require.Equal(t, int64(2), blocks[1].Height)
+	require.Len(t, blocks[1].Events, 1)
+}
@@ -0,0 +1,225 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/types/rest"
+)
+
+const (
+	defaultLimit = 100
+	maxLimit     = 1000
+)
+
+// Collection is the NFTs of a denom held by an owner
+type Collection struct {
+	DenomID string `json:"denom_id"`
+	NFTs    []NFT  `json:"nfts"`
+}
+
+// Portfolio is the NFTs of an owner across the denoms
+type Portfolio struct {
+	Owner       string       `json:"owner"`
+	Collections []Collection `json:"collections"`
+}
+
+// NewRouter returns the routes of the HTTP/JSON query API of the store, the
+// lists are paginated with the page and limit parameters
+func NewRouter(store *Store) *mux.Router {
+	r := mux.NewRouter()
+
+	// Get the last indexed height
+	r.HandleFunc("/status", queryStatus(store)).Methods("GET")
+
+	// Query all denoms
+	r.HandleFunc("/denoms", queryDenoms(store)).Methods("GET")
+
+	// Query the denom
+	r.HandleFunc("/denoms/{denom}", queryDenom(store)).Methods("GET")
+
+	// Search the NFTs by name, denom and owner
+	r.HandleFunc("/nfts", queryNFTs(store)).Methods("GET")
+
+	// Query a single NFT
+	r.HandleFunc("/nfts/{denom}/{id}", queryNFT(store)).Methods("GET")
+
+	// Get the transfers of a NFT
+	r.HandleFunc("/nfts/{denom}/{id}/history", queryTokenHistory(store)).Methods("GET")
+
+	// Get the NFTs of an owner across the denoms
+	r.HandleFunc("/owners/{owner}", queryPortfolio(store)).Methods("GET")
+
+	// Get the transfers sent or received by an account
+	r.HandleFunc("/owners/{owner}/history", queryAccountHistory(store)).Methods("GET")
+
+	return r
+}
+
+func queryStatus(store *Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		height, err := store.Height()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		writeJSON(w, struct {
+			Height int64 `json:"height"`
+		}{height})
+	}
+}
+
+func queryDenoms(store *Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		limit, offset, ok := parsePagination(w, r)
+		if !ok {
+			return
+		}
+		denoms, err := store.Denoms(limit, offset)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		writeJSON(w, denoms)
+	}
+}
+
+func queryDenom(store *Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		denom, err := store.Denom(mux.Vars(r)["denom"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		if denom == nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, "denom not found")
+			return
+		}
+		writeJSON(w, denom)
+	}
+}
+
+func queryNFTs(store *Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		limit, offset, ok := parsePagination(w, r)
+		if !ok {
+			return
+		}
+		nfts, err := store.NFTs(NFTFilter{
+			Name:    r.FormValue("name"),
+			DenomID: r.FormValue("denom"),
+			Owner:   r.FormValue("owner"),
+			Limit:   limit,
+			Offset:  offset,
+		})
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		writeJSON(w, nfts)
+	}
+}
+
+func queryNFT(store *Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		nft, err := store.NFT(vars["denom"], vars["id"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		if nft == nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, "nft not found")
+			return
+		}
+		writeJSON(w, nft)
+	}
+}
+
+func queryTokenHistory(store *Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		limit, offset, ok := parsePagination(w, r)
+		if !ok {
+			return
+		}
+		vars := mux.Vars(r)
+		transfers, err := store.TokenHistory(vars["denom"], vars["id"], limit, offset)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		writeJSON(w, transfers)
+	}
+}
+
+func queryPortfolio(store *Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		limit, offset, ok := parsePagination(w, r)
+		if !ok {
+			return
+		}
+		owner := mux.Vars(r)["owner"]
+		nfts, err := store.NFTs(NFTFilter{Owner: owner, Limit: limit, Offset: offset})
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// the NFTs are ordered by denom
+		portfolio := Portfolio{Owner: owner, Collections: []Collection{}}
+		for _, nft := range nfts {
+			last := len(portfolio.Collections) - 1
+			if last < 0 || portfolio.Collections[last].DenomID != nft.DenomID {
+				portfolio.Collections = append(portfolio.Collections, Collection{DenomID: nft.DenomID})
+				last++
+			}
+			portfolio.Collections[last].NFTs = append(portfolio.Collections[last].NFTs, nft)
+		}
+		writeJSON(w, portfolio)
+	}
+}
+
+func queryAccountHistory(store *Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		limit, offset, ok := parsePagination(w, r)
+		if !ok {
+			return
+		}
+		transfers, err := store.AccountHistory(mux.Vars(r)["owner"], limit, offset)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		writeJSON(w, transfers)
+	}
+}
+
+// parsePagination returns the limit and offset of the page of the request
+func parsePagination(w http.ResponseWriter, r *http.Request) (limit, offset int, ok bool) {
+	page, limit := 1, defaultLimit
+	for param, value := range map[string]*int{"page": &page, "limit": &limit} {
+		s := r.FormValue(param)
+		if len(s) == 0 {
+			continue
+		}
+		n, err := strconv.Atoi(s)
+		if err != nil || n <= 0 {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("%s must be a positive integer", param))
+			return 0, 0, false
+		}
+		*value = n
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+	return limit, (page - 1) * limit, true
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v) // nolint: errcheck
+}
@@ -0,0 +1,341 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc/metadata"
+
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+
+	"github.com/irismod/nft/client/watch"
+	"github.com/irismod/nft/types"
+)
+
+// Indexer writes the denoms, NFTs and transfers of the blocks to the store.
+// The events don't carry every field of the denoms and NFTs, the missing ones
+// are queried from the state at the height of the block. The governance burns
+// and creator reassignments are indexed from the typed events of the proposal
+// handler. The token uris are stored as in the state, not resolved against the
+// base uri of the denom: the resolved uris of the events are replaced with the
+// queried ones.
+type Indexer struct {
+	store *Store
+	query types.QueryClient
+}
+
+// NewIndexer creates an Indexer querying the node over the connection, the
+// node must keep the state of the heights not indexed yet
+func NewIndexer(store *Store, conn gogogrpc.ClientConn) *Indexer {
+	return &Indexer{
+		store: store,
+		query: types.NewQueryClient(conn),
+	}
+}
+
+// Run indexes the blocks until the context is done, it resumes after the last
+// indexed height. An empty store is first loaded with the state at the start
+// height, or at the latest height when zero, and the blocks after it are
+// indexed.
+func (idx *Indexer) Run(ctx context.Context, dial watch.Dialer, startHeight int64) error {
+	height, err := idx.store.Height()
+	if err != nil {
+		return err
+	}
+
+	if height == 0 {
+		if startHeight == 0 {
+			node, err := dial()
+			if err != nil {
+				return err
+			}
+			status, err := node.Status()
+			if err != nil {
+				return err
+			}
+			startHeight = status.SyncInfo.LatestBlockHeight
+		}
+		if err := idx.Load(ctx, startHeight); err != nil {
+			return err
+		}
+		height = startHeight
+	}
+
+	return watch.WatchBlocks(ctx, dial, watch.Config{FromHeight: height + 1}, func(block watch.Block) error {
+		return idx.IndexBlock(ctx, block)
+	})
+}
+
+// Load replaces the content of an empty store with the denoms and NFTs of the
+// state at the height
+func (idx *Indexer) Load(ctx context.Context, height int64) error {
+	ctx = atHeight(ctx, height)
+
+	denomsRes, err := idx.query.Denoms(ctx, &types.QueryDenomsRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to query the denoms at height %d: %w", height, err)
+	}
+
+	denoms := make([]Denom, len(denomsRes.Denoms))
+	var nfts []NFT
+	for i, denom := range denomsRes.Denoms {
+		pausedRes, err := idx.query.Paused(ctx, &types.QueryPausedRequest{Denom: denom.Id})
+		if err != nil {
+			return fmt.Errorf("failed to query the denom %s at height %d: %w", denom.Id, height, err)
+		}
+		denoms[i] = newDenom(denom)
+		denoms[i].Paused = pausedRes.DenomPaused
+
+		res, err := idx.query.Collection(ctx, &types.QueryCollectionRequest{Denom: denom.Id, RawURIs: true})
+		if err != nil {
+			return fmt.Errorf("failed to query the collection %s at height %d: %w", denom.Id, height, err)
+		}
+		for _, nft := range res.Collection.NFTs {
+			nfts = append(nfts, newNFT(denom.Id, nft))
+		}
+	}
+
+	return idx.store.Update(height, func(b *Batch) error {
+		for _, denom := range denoms {
+			if err := b.SetDenom(denom); err != nil {
+				return err
+			}
+		}
+		for _, nft := range nfts {
+			if err := b.SetNFT(nft); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// IndexBlock writes the events of the block, a block already indexed is skipped
+func (idx *Indexer) IndexBlock(ctx context.Context, block watch.Block) error {
+	height, err := idx.store.Height()
+	if err != nil {
+		return err
+	}
+	if block.Height <= height {
+		return nil
+	}
+
+	// the denoms and NFTs created in the block are queried before writing, a
+	// NFT burned by the end of the block is no longer in its state
+	denoms := make(map[string]Denom)
+	nfts := make(map[string]NFT)
+	for _, event := range toQuery(block.Events) {
+		switch e := event.Event.(type) {
+		case *types.EventIssueDenom:
+			res, err := idx.query.Denom(atHeight(ctx, block.Height), &types.QueryDenomRequest{Denom: e.DenomId})
+			if err != nil {
+				return fmt.Errorf("failed to query the denom %s at height %d: %w", e.DenomId, block.Height, err)
+			}
+			denoms[e.DenomId] = newDenom(*res.Denom)
+		default:
+			denomID, tokenID := nftOf(event)
+			if _, ok := nfts[nftKey(denomID, tokenID)]; ok {
+				continue
+			}
+			res, err := idx.query.NFT(atHeight(ctx, block.Height), &types.QueryNFTRequest{Denom: denomID, Id: tokenID, RawURI: true})
+			if err != nil {
+				return fmt.Errorf("failed to query the NFT %s/%s at height %d: %w", denomID, tokenID, block.Height, err)
+			}
+			nfts[nftKey(denomID, tokenID)] = newNFT(denomID, *res.NFT)
+		}
+	}
+
+	return idx.store.Update(block.Height, func(b *Batch) error {
+		for _, event := range block.Events {
+			if err := applyEvent(b, event, denoms, nfts); err != nil {
+				return fmt.Errorf("height %d: %w", block.Height, err)
+			}
+		}
+		return nil
+	})
+}
+
+// toQuery returns the events of the denoms and NFTs to query, the mints and
+// the changes of the uris, skipping the NFTs burned later in the block
+func toQuery(events []watch.Event) []watch.Event {
+	burned := make(map[string]bool)
+	for _, event := range events {
+		switch e := event.Event.(type) {
+		case *types.EventMint:
+			burned[nftKey(e.DenomId, e.TokenId)] = false
+		case *types.EventBurn:
+			burned[nftKey(e.DenomId, e.TokenId)] = true
+		case *types.EventRevoke:
+			burned[nftKey(e.DenomId, e.TokenId)] = len(e.Recipient) == 0
+		}
+	}
+
+	var queried []watch.Event
+	for _, event := range events {
+		switch e := event.Event.(type) {
+		case *types.EventIssueDenom:
+			queried = append(queried, event)
+		case *types.EventMint:
+			if !burned[nftKey(e.DenomId, e.TokenId)] {
+				queried = append(queried, event)
+			}
+		case *types.EventEdit:
+			if _, ok := changedFields(e.Changes)["uri"]; ok && !burned[nftKey(e.DenomId, e.TokenId)] {
+				queried = append(queried, event)
+			}
+		case *types.EventTransfer:
+			if _, ok := changedFields(e.Changes)["uri"]; ok && !burned[nftKey(e.DenomId, e.TokenId)] {
+				queried = append(queried, event)
+			}
+		}
+	}
+	return queried
+}
+
+// nftOf returns the NFT of a mint, edit or transfer event
+func nftOf(event watch.Event) (denomID, tokenID string) {
+	switch e := event.Event.(type) {
+	case *types.EventMint:
+		return e.DenomId, e.TokenId
+	case *types.EventEdit:
+		return e.DenomId, e.TokenId
+	case *types.EventTransfer:
+		return e.DenomId, e.TokenId
+	}
+	return "", ""
+}
+
+// applyEvent writes the changes of the event, the denoms and NFTs created are
+// taken from the queried ones
+func applyEvent(b *Batch, event watch.Event, denoms map[string]Denom, nfts map[string]NFT) error {
+	switch e := event.Event.(type) {
+	case *types.EventIssueDenom:
+		return b.SetDenom(denoms[e.DenomId])
+
+	case *types.EventPauseDenom:
+		return b.SetPaused(e.DenomId, e.Paused)
+
+	case *types.EventReassignDenomCreator:
+		return b.SetCreator(e.DenomId, e.Creator)
+
+	case *types.EventMint:
+		nft, ok := nfts[nftKey(e.DenomId, e.TokenId)]
+		if !ok {
+			nft = NFT{DenomID: e.DenomId, TokenID: e.TokenId, URI: e.TokenURI}
+		}
+		// the queried state is the one of the end of the block, the owner is the
+		// one of the event until the later events of the block are applied
+		nft.Owner = e.Recipient
+		if err := b.SetNFT(nft); err != nil {
+			return err
+		}
+		return b.AddTransfer(Transfer{
+			TxHash:    event.TxHash,
+			DenomID:   e.DenomId,
+			TokenID:   e.TokenId,
+			Action:    actionMint,
+			Recipient: e.Recipient,
+		})
+
+	case *types.EventEdit:
+		return b.UpdateNFT(e.DenomId, e.TokenId, rawURIFields(changedFields(e.Changes), nfts[nftKey(e.DenomId, e.TokenId)]))
+
+	case *types.EventTransfer:
+		fields := rawURIFields(changedFields(e.Changes), nfts[nftKey(e.DenomId, e.TokenId)])
+		fields["owner"] = e.Recipient
+		if err := b.UpdateNFT(e.DenomId, e.TokenId, fields); err != nil {
+			return err
+		}
+		return b.AddTransfer(Transfer{
+			TxHash:    event.TxHash,
+			DenomID:   e.DenomId,
+			TokenID:   e.TokenId,
+			Action:    actionTransfer,
+			Sender:    e.Sender,
+			Recipient: e.Recipient,
+		})
+
+	case *types.EventBurn:
+		if err := b.DeleteNFT(e.DenomId, e.TokenId); err != nil {
+			return err
+		}
+		return b.AddTransfer(Transfer{
+			TxHash:  event.TxHash,
+			DenomID: e.DenomId,
+			TokenID: e.TokenId,
+			Action:  actionBurn,
+			Sender:  e.Owner,
+		})
+
+	case *types.EventRevoke:
+		var err error
+		if len(e.Recipient) == 0 {
+			err = b.DeleteNFT(e.DenomId, e.TokenId)
+		} else {
+			err = b.UpdateNFT(e.DenomId, e.TokenId, map[string]string{"owner": e.Recipient})
+		}
+		if err != nil {
+			return err
+		}
+		return b.AddTransfer(Transfer{
+			TxHash:    event.TxHash,
+			DenomID:   e.DenomId,
+			TokenID:   e.TokenId,
+			Action:    actionRevoke,
+			Sender:    e.Owner,
+			Recipient: e.Recipient,
+		})
+	}
+	return nil
+}
+
+func changedFields(changes []types.FieldChange) map[string]string {
+	fields := make(map[string]string, len(changes))
+	for _, change := range changes {
+		fields[change.Field] = change.NewValue
+	}
+	return fields
+}
+
+// rawURIFields replaces the resolved uri of the changed fields with the uri
+// stored in the queried state of the NFT
+func rawURIFields(fields map[string]string, queried NFT) map[string]string {
+	if _, ok := fields["uri"]; ok && len(queried.TokenID) > 0 {
+		fields["uri"] = queried.URI
+	}
+	return fields
+}
+
+func newDenom(denom types.Denom) Denom {
+	return Denom{
+		ID:      denom.Id,
+		Name:    denom.Name,
+		Schema:  denom.Schema,
+		Creator: denom.Creator.String(),
+		URI:     denom.URI,
+		URIHash: denom.URIHash,
+	}
+}
+
+func newNFT(denomID string, nft types.BaseNFT) NFT {
+	return NFT{
+		DenomID: denomID,
+		TokenID: nft.Id,
+		Name:    nft.Name,
+		URI:     nft.URI,
+		URIHash: nft.URIHash,
+		Data:    nft.Data,
+		Owner:   nft.Owner.String(),
+	}
+}
+
+func nftKey(denomID, tokenID string) string {
+	return denomID + "/" + tokenID
+}
+
+// atHeight makes the queries of the context read the state at the height
+func atHeight(ctx context.Context, height int64) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+}
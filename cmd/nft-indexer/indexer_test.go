@@ -0,0 +1,246 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+
+	"github.com/irismod/nft/client/watch"
+	"github.com/irismod/nft/types"
+)
+
+var (
+	alice = sdk.AccAddress("alice_______________")
+	bob   = sdk.AccAddress("bob_________________")
+)
+
+// fakeQuery serves the denoms and NFTs of the state at every height, with the
+// token uris as stored; the queries not used by the indexer are left to the
+// nil embedded interface
+type fakeQuery struct {
+	types.QueryClient
+
+	denoms map[int64][]types.Denom
+	nfts   map[int64]map[string]types.BaseNFT
+}
+
+func newFakeQuery() *fakeQuery {
+	return &fakeQuery{
+		denoms: make(map[int64][]types.Denom),
+		nfts:   make(map[int64]map[string]types.BaseNFT),
+	}
+}
+
+func (q *fakeQuery) setNFT(height int64, denomID string, nft types.BaseNFT) {
+	if q.nfts[height] == nil {
+		q.nfts[height] = make(map[string]types.BaseNFT)
+	}
+	q.nfts[height][nftKey(denomID, nft.Id)] = nft
+}
+
+func queryHeight(ctx context.Context) int64 {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	var height int64
+	fmt.Sscan(md.Get(grpctypes.GRPCBlockHeightHeader)[0], &height) // nolint: errcheck
+	return height
+}
+
+func (q *fakeQuery) Denoms(ctx context.Context, _ *types.QueryDenomsRequest, _ ...grpc.CallOption) (*types.QueryDenomsResponse, error) {
+	return &types.QueryDenomsResponse{Denoms: q.denoms[queryHeight(ctx)]}, nil
+}
+
+func (q *fakeQuery) Denom(ctx context.Context, req *types.QueryDenomRequest, _ ...grpc.CallOption) (*types.QueryDenomResponse, error) {
+	for _, denom := range q.denoms[queryHeight(ctx)] {
+		if denom.Id == req.Denom {
+			denom := denom
+			return &types.QueryDenomResponse{Denom: &denom}, nil
+		}
+	}
+	return nil, types.ErrInvalidDenom
+}
+
+func (q *fakeQuery) Paused(context.Context, *types.QueryPausedRequest, ...grpc.CallOption) (*types.QueryPausedResponse, error) {
+	return &types.QueryPausedResponse{}, nil
+}
+
+func (q *fakeQuery) Collection(ctx context.Context, req *types.QueryCollectionRequest, _ ...grpc.CallOption) (*types.QueryCollectionResponse, error) {
+	if !req.RawURIs {
+		return nil, fmt.Errorf("the indexer must query the stored uris")
+	}
+	collection := &types.Collection{}
+	for key, nft := range q.nfts[queryHeight(ctx)] {
+		if key == nftKey(req.Denom, nft.Id) {
+			collection.NFTs = append(collection.NFTs, nft)
+		}
+	}
+	return &types.QueryCollectionResponse{Collection: collection}, nil
+}
+
+func (q *fakeQuery) NFT(ctx context.Context, req *types.QueryNFTRequest, _ ...grpc.CallOption) (*types.QueryNFTResponse, error) {
+	if !req.RawURI {
+		return nil, fmt.Errorf("the indexer must query the stored uris")
+	}
+	nft, ok := q.nfts[queryHeight(ctx)][nftKey(req.Denom, req.Id)]
+	if !ok {
+		return nil, types.ErrUnknownNFT
+	}
+	return &types.QueryNFTResponse{NFT: &nft}, nil
+}
+
+func newBlock(height int64, msgs ...proto.Message) watch.Block {
+	block := watch.Block{Height: height}
+	for i, msg := range msgs {
+		block.Events = append(block.Events, watch.Event{
+			Height: height,
+			TxHash: fmt.Sprintf("TX%d%d", height, i),
+			Type:   proto.MessageName(msg),
+			Event:  msg,
+		})
+	}
+	return block
+}
+
+func setupIndexer(t *testing.T) (*Indexer, *fakeQuery, string) {
+	path := filepath.Join(t.TempDir(), "nft.db")
+	store, err := OpenStore(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { store.Close() }) // nolint: errcheck
+
+	query := newFakeQuery()
+	return &Indexer{store: store, query: query}, query, path
+}
+
+func TestIndexBlock(t *testing.T) {
+	indexer, query, path := setupIndexer(t)
+	ctx := context.Background()
+
+	// the state at height 1 seeds the store
+	query.denoms[1] = []types.Denom{{Id: "kitty", Name: "Kitties", Creator: alice}}
+	query.setNFT(1, "kitty", types.BaseNFT{Id: "k1", Name: "Tom", Owner: alice})
+	require.NoError(t, indexer.Load(ctx, 1))
+
+	query.denoms[2] = []types.Denom{{Id: "kitty", Name: "Kitties", Creator: alice}, {Id: "puppy", Name: "Puppies", Creator: bob}}
+	query.setNFT(2, "puppy", types.BaseNFT{Id: "p1", Name: "Rex", URI: "ipfs://rex", Owner: alice})
+	require.NoError(t, indexer.IndexBlock(ctx, newBlock(2,
+		&types.EventIssueDenom{DenomId: "puppy", Creator: bob.String()},
+		&types.EventMint{DenomId: "puppy", TokenId: "p1", Sender: bob.String(), Recipient: bob.String()},
+		&types.EventTransfer{DenomId: "puppy", TokenId: "p1", Sender: bob.String(), Recipient: alice.String()},
+		// minted and burned in the block, it isn't in the state of the height
+		&types.EventMint{DenomId: "puppy", TokenId: "p2", TokenURI: "ipfs://p2", Recipient: bob.String()},
+		&types.EventBurn{DenomId: "puppy", TokenId: "p2", Owner: bob.String()},
+	)))
+
+	// the events carry the uri resolved against the base uri, the stored one is
+	// relative
+	query.setNFT(3, "kitty", types.BaseNFT{Id: "k1", Name: "Tommy", URI: "{id}.json", Owner: bob})
+	require.NoError(t, indexer.IndexBlock(ctx, newBlock(3,
+		&types.EventEdit{DenomId: "kitty", TokenId: "k1", Changes: []types.FieldChange{
+			{Field: "name", OldValue: "Tom", NewValue: "Tommy"},
+			{Field: "uri", OldValue: "ipfs://kitties/k1", NewValue: "ipfs://kitties/k1.json"},
+		}},
+		&types.EventTransfer{DenomId: "kitty", TokenId: "k1", Sender: alice.String(), Recipient: bob.String()},
+		&types.EventPauseDenom{DenomId: "puppy", Paused: true},
+		// emitted by a governance proposal at the end of the block
+		&types.EventReassignDenomCreator{DenomId: "kitty", Creator: bob.String(), PreviousCreator: alice.String()},
+	)))
+
+	// an indexed block is skipped
+	require.NoError(t, indexer.IndexBlock(ctx, newBlock(3,
+		&types.EventBurn{DenomId: "kitty", TokenId: "k1", Owner: bob.String()},
+	)))
+
+	// the store is restart-safe, a reopened one resumes after the last height
+	require.NoError(t, indexer.store.Close())
+	store, err := OpenStore(path)
+	require.NoError(t, err)
+	indexer.store = store
+	height, err := store.Height()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), height)
+
+	server := httptest.NewServer(NewRouter(store))
+	defer server.Close()
+
+	var nft NFT
+	get(t, server.URL+"/nfts/kitty/k1", http.StatusOK, &nft)
+	require.Equal(t, NFT{DenomID: "kitty", TokenID: "k1", Name: "Tommy", URI: "{id}.json", Owner: bob.String(), Height: 3}, nft)
+
+	get(t, server.URL+"/nfts/puppy/p1", http.StatusOK, &nft)
+	require.Equal(t, "Rex", nft.Name)
+	require.Equal(t, alice.String(), nft.Owner)
+	get(t, server.URL+"/nfts/puppy/p2", http.StatusNotFound, nil)
+
+	var denom Denom
+	get(t, server.URL+"/denoms/puppy", http.StatusOK, &denom)
+	require.Equal(t, Denom{ID: "puppy", Name: "Puppies", Creator: bob.String(), Paused: true, Height: 3}, denom)
+	get(t, server.URL+"/denoms/kitty", http.StatusOK, &denom)
+	require.Equal(t, bob.String(), denom.Creator)
+
+	var denoms []Denom
+	get(t, server.URL+"/denoms?limit=1&page=2", http.StatusOK, &denoms)
+	require.Len(t, denoms, 1)
+	require.Equal(t, "puppy", denoms[0].ID)
+
+	var nfts []NFT
+	get(t, server.URL+"/nfts?name=tom", http.StatusOK, &nfts)
+	require.Len(t, nfts, 1)
+	require.Equal(t, "k1", nfts[0].TokenID)
+	get(t, server.URL+"/nfts?name=%25", http.StatusOK, &nfts)
+	require.Empty(t, nfts)
+
+	var portfolio Portfolio
+	get(t, server.URL+"/owners/"+bob.String(), http.StatusOK, &portfolio)
+	require.Len(t, portfolio.Collections, 1)
+	require.Equal(t, "kitty", portfolio.Collections[0].DenomID)
+
+	var history []Transfer
+	get(t, server.URL+"/nfts/puppy/p2/history", http.StatusOK, &history)
+	require.Equal(t, []Transfer{
+		{Height: 2, TxHash: "TX24", DenomID: "puppy", TokenID: "p2", Action: actionBurn, Sender: bob.String()},
+		{Height: 2, TxHash: "TX23", DenomID: "puppy", TokenID: "p2", Action: actionMint, Recipient: bob.String()},
+	}, history)
+
+	get(t, server.URL+"/owners/"+alice.String()+"/history", http.StatusOK, &history)
+	require.Len(t, history, 2)
+	require.Equal(t, "k1", history[0].TokenID)
+	require.Equal(t, "p1", history[1].TokenID)
+
+	get(t, server.URL+"/denoms?limit=x", http.StatusBadRequest, nil)
+}
+
+func TestIndexBlockQueryError(t *testing.T) {
+	indexer, _, _ := setupIndexer(t)
+
+	// a NFT missing from the state fails the block, nothing of it is written
+	err := indexer.IndexBlock(context.Background(), newBlock(1,
+		&types.EventMint{DenomId: "kitty", TokenId: "k1", Recipient: alice.String()},
+	))
+	require.Error(t, err)
+
+	height, err := indexer.store.Height()
+	require.NoError(t, err)
+	require.Zero(t, height)
+}
+
+func get(t *testing.T, url string, status int, v interface{}) {
+	res, err := http.Get(url)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	require.Equal(t, status, res.StatusCode)
+	if v != nil {
+		require.NoError(t, json.NewDecoder(res.Body).Decode(v))
+	}
+}
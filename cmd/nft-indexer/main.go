@@ -0,0 +1,120 @@
+// nft-indexer indexes the denoms, NFTs and transfers of the NFT module into a
+// SQLite database and serves them over an HTTP/JSON API. The indexing is
+// restart-safe: every block is written in a single transaction with its
+// height, and the indexer resumes after the last indexed height.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+const (
+	flagNode        = "node"
+	flagDB          = "db"
+	flagListen      = "listen"
+	flagStartHeight = "start-height"
+)
+
+func main() {
+	if err := newRootCmd().Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func newRootCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nft-indexer",
+		Short: "Index the NFT module into SQLite and serve it over HTTP",
+		Long: `Index the denoms, NFTs and transfers of the NFT module into a SQLite database and serve them over an HTTP/JSON API.
+An empty database is loaded with the state at the start height, the latest one by default, and the blocks after it are indexed.
+A restarted indexer resumes after the last indexed height, the node must keep the state of the heights not indexed yet.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			node, _ := cmd.Flags().GetString(flagNode)
+			dbPath, _ := cmd.Flags().GetString(flagDB)
+			listen, _ := cmd.Flags().GetString(flagListen)
+			startHeight, _ := cmd.Flags().GetInt64(flagStartHeight)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			sigs := make(chan os.Signal, 1)
+			signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+			go func() {
+				<-sigs
+				cancel()
+			}()
+
+			return run(ctx, node, dbPath, listen, startHeight)
+		},
+	}
+
+	cmd.Flags().String(flagNode, "tcp://localhost:26657", "<host>:<port> to Tendermint RPC interface for this chain")
+	cmd.Flags().String(flagDB, "nft-indexer.db", "path of the SQLite database")
+	cmd.Flags().String(flagListen, "localhost:8080", "address the HTTP API listens on")
+	cmd.Flags().Int64(flagStartHeight, 0, "height whose state is loaded into an empty database, the latest when zero")
+	return cmd
+}
+
+// run indexes the chain of the node and serves the API until the context is done
+func run(ctx context.Context, node, dbPath, listen string, startHeight int64) error {
+	store, err := OpenStore(dbPath)
+	if err != nil {
+		return err
+	}
+	defer store.Close() // nolint: errcheck
+
+	queryNode, err := rpchttp.New(node, "/websocket")
+	if err != nil {
+		return err
+	}
+	indexer := NewIndexer(store, client.Context{}.WithClient(queryNode))
+	dial := func() (rpcclient.Client, error) {
+		return rpchttp.New(node, "/websocket")
+	}
+
+	server := &http.Server{Addr: listen, Handler: NewRouter(store)}
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- server.ListenAndServe()
+	}()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	indexErr := make(chan error, 1)
+	go func() {
+		indexErr <- indexer.Run(ctx, dial, startHeight)
+	}()
+
+	// the indexer is stopped before closing the store when the server fails
+	select {
+	case err = <-serveErr:
+		cancel()
+		<-indexErr
+	case err = <-indexErr:
+	}
+
+	shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelShutdown()
+	if shutdownErr := server.Shutdown(shutdownCtx); shutdownErr != nil && err == nil {
+		err = shutdownErr
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
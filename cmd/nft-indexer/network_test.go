@@ -0,0 +1,205 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/irismod/nft/app"
+	"github.com/irismod/nft/client/nftclient"
+)
+
+const from = "node0"
+
+type IndexerTestSuite struct {
+	suite.Suite
+
+	network *network.Network
+	conn    *grpc.ClientConn
+	client  *nftclient.Client
+}
+
+func (s *IndexerTestSuite) SetupSuite() {
+	encCfg := simapp.MakeEncodingConfig()
+	cfg := network.DefaultConfig()
+	cfg.Codec = encCfg.Marshaler
+	cfg.TxConfig = encCfg.TxConfig
+	cfg.LegacyAmino = encCfg.Amino
+	cfg.InterfaceRegistry = encCfg.InterfaceRegistry
+	cfg.GenesisState = simapp.ModuleBasics.DefaultGenesis(encCfg.Marshaler)
+	cfg.NumValidators = 1
+	cfg.AppConstructor = func(val network.Validator) servertypes.Application {
+		return simapp.NewSimApp(
+			val.Ctx.Logger, dbm.NewMemDB(), nil, true, make(map[int64]bool), val.Ctx.Config.RootDir, 0,
+			encCfg, simapp.EmptyAppOptions{},
+			baseapp.SetPruning(storetypes.NewPruningOptionsFromString(storetypes.PruningOptionNothing)),
+			baseapp.SetMinGasPrices(val.AppConfig.MinGasPrices),
+		)
+	}
+
+	s.network = network.New(s.T(), cfg)
+	_, err := s.network.WaitForHeight(1)
+	s.Require().NoError(err)
+
+	val := s.network.Validators[0]
+	s.conn, err = grpc.Dial(val.AppConfig.GRPC.Address, grpc.WithInsecure())
+	s.Require().NoError(err)
+
+	s.client, err = nftclient.New(nftclient.Config{
+		ChainID:   cfg.ChainID,
+		NodeURI:   val.RPCAddress,
+		GasPrices: cfg.MinGasPrices,
+	}, s.conn, val.ClientCtx.Keyring)
+	s.Require().NoError(err)
+}
+
+func (s *IndexerTestSuite) TearDownSuite() {
+	s.Require().NoError(s.conn.Close())
+	s.network.Cleanup()
+}
+
+func TestIndexerTestSuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping the in-process network with -short")
+	}
+	if err := checkTestKeyring(t); err != nil {
+		t.Skipf("skipping the in-process network, its test keyring is unusable: %s", err)
+	}
+	suite.Run(t, new(IndexerTestSuite))
+}
+
+// checkTestKeyring creates a key in the file based keyring of the network, the
+// JOSE library it encrypts the keys with panics with the HMAC checks of go1.24+
+func checkTestKeyring(t *testing.T) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	kr, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, t.TempDir(), nil)
+	if err != nil {
+		return err
+	}
+	_, _, err = kr.NewMnemonic("check", keyring.English, sdk.FullFundraiserPath, hd.Secp256k1)
+	return err
+}
+
+// startIndexer runs an indexer on the store until the returned function is called
+func (s *IndexerTestSuite) startIndexer(store *Store, startHeight int64) func() {
+	val := s.network.Validators[0]
+	queryNode, err := rpchttp.New(val.RPCAddress, "/websocket")
+	s.Require().NoError(err)
+	indexer := NewIndexer(store, client.Context{}.WithClient(queryNode))
+	dial := func() (rpcclient.Client, error) {
+		return rpchttp.New(val.RPCAddress, "/websocket")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- indexer.Run(ctx, dial, startHeight)
+	}()
+	return func() {
+		cancel()
+		s.Require().Equal(context.Canceled, <-done)
+	}
+}
+
+// waitForHeight waits until the store indexed the height
+func (s *IndexerTestSuite) waitForHeight(store *Store, height int64) {
+	s.Require().Eventually(func() bool {
+		indexed, err := store.Height()
+		s.Require().NoError(err)
+		return indexed >= height
+	}, 30*time.Second, 100*time.Millisecond)
+}
+
+func (s *IndexerTestSuite) TestIndexer() {
+	ctx := context.Background()
+	owner := s.network.Validators[0].Address
+
+	_, err := s.client.IssueDenom(ctx, from, "kitty", "kitties", "", "", "", "", 0, false)
+	s.Require().NoError(err)
+
+	mint, err := s.client.Mint(ctx, from, "kitty", "kitty1", "Tom", "ipfs://tom", "", "", nil, nil)
+	s.Require().NoError(err)
+	s.Require().Equal("kitty1", mint.TokenId)
+
+	// an empty store is loaded with the state of the latest height
+	store, err := OpenStore(filepath.Join(s.T().TempDir(), "nft.db"))
+	s.Require().NoError(err)
+	defer store.Close() // nolint: errcheck
+
+	stop := s.startIndexer(store, 0)
+	status, err := s.network.Validators[0].RPCClient.Status()
+	s.Require().NoError(err)
+	s.waitForHeight(store, status.SyncInfo.LatestBlockHeight)
+
+	nft, err := store.NFT("kitty", "kitty1")
+	s.Require().NoError(err)
+	s.Require().Equal("Tom", nft.Name)
+	s.Require().Equal(owner.String(), nft.Owner)
+
+	// the blocks after it are indexed as they are committed
+	info, _, err := s.network.Validators[0].ClientCtx.Keyring.NewMnemonic("recipient", keyring.English, sdk.FullFundraiserPath, hd.Secp256k1)
+	s.Require().NoError(err)
+	recipient := info.GetAddress()
+
+	_, err = s.client.Mint(ctx, from, "kitty", "kitty2", "Garfield", "ipfs://garfield", "", "", nil, nil)
+	s.Require().NoError(err)
+	_, err = s.client.Transfer(ctx, from, "kitty", "kitty2", recipient)
+	s.Require().NoError(err)
+
+	status, err = s.network.Validators[0].RPCClient.Status()
+	s.Require().NoError(err)
+	s.waitForHeight(store, status.SyncInfo.LatestBlockHeight)
+
+	nfts, err := store.NFTs(NFTFilter{Name: "garf", Limit: 10})
+	s.Require().NoError(err)
+	s.Require().Len(nfts, 1)
+	s.Require().Equal(recipient.String(), nfts[0].Owner)
+
+	// a restarted indexer catches up with the blocks committed while stopped
+	stop()
+	_, err = s.client.Burn(ctx, from, "kitty", "kitty1")
+	s.Require().NoError(err)
+	status, err = s.network.Validators[0].RPCClient.Status()
+	s.Require().NoError(err)
+
+	stop = s.startIndexer(store, 0)
+	defer stop()
+	s.waitForHeight(store, status.SyncInfo.LatestBlockHeight)
+
+	nft, err = store.NFT("kitty", "kitty1")
+	s.Require().NoError(err)
+	s.Require().Nil(nft)
+
+	history, err := store.TokenHistory("kitty", "kitty1", 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(history, 1)
+	s.Require().Equal(actionBurn, history[0].Action)
+
+	history, err = store.AccountHistory(recipient.String(), 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(history, 1)
+	s.Require().Equal(actionTransfer, history[0].Action)
+}
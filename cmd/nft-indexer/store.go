@@ -0,0 +1,383 @@
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	// registers the sqlite3 driver
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const schema = `
+CREATE TABLE IF NOT EXISTS state (
+	id     INTEGER PRIMARY KEY CHECK (id = 0),
+	height INTEGER NOT NULL
+);
+CREATE TABLE IF NOT EXISTS denoms (
+	id       TEXT PRIMARY KEY,
+	name     TEXT NOT NULL,
+	schema   TEXT NOT NULL,
+	creator  TEXT NOT NULL,
+	uri      TEXT NOT NULL,
+	uri_hash TEXT NOT NULL,
+	paused   INTEGER NOT NULL DEFAULT 0,
+	height   INTEGER NOT NULL
+);
+CREATE TABLE IF NOT EXISTS nfts (
+	denom_id TEXT NOT NULL,
+	token_id TEXT NOT NULL,
+	name     TEXT NOT NULL,
+	uri      TEXT NOT NULL,
+	uri_hash TEXT NOT NULL,
+	data     TEXT NOT NULL,
+	owner    TEXT NOT NULL,
+	height   INTEGER NOT NULL,
+	PRIMARY KEY (denom_id, token_id)
+);
+CREATE INDEX IF NOT EXISTS nfts_owner ON nfts (owner, denom_id, token_id);
+CREATE TABLE IF NOT EXISTS transfers (
+	id        INTEGER PRIMARY KEY AUTOINCREMENT,
+	height    INTEGER NOT NULL,
+	tx_hash   TEXT NOT NULL,
+	denom_id  TEXT NOT NULL,
+	token_id  TEXT NOT NULL,
+	action    TEXT NOT NULL,
+	sender    TEXT NOT NULL,
+	recipient TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS transfers_token ON transfers (denom_id, token_id);
+CREATE INDEX IF NOT EXISTS transfers_sender ON transfers (sender);
+CREATE INDEX IF NOT EXISTS transfers_recipient ON transfers (recipient);
+`
+
+// The actions recorded in the transfer history
+const (
+	actionMint     = "mint"
+	actionTransfer = "transfer"
+	actionBurn     = "burn"
+	actionRevoke   = "revoke"
+)
+
+// Denom is an indexed denom
+type Denom struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Schema  string `json:"schema"`
+	Creator string `json:"creator"`
+	URI     string `json:"uri"`
+	URIHash string `json:"uri_hash"`
+	Paused  bool   `json:"paused"`
+	Height  int64  `json:"height"`
+}
+
+// NFT is an indexed NFT, the height is the last one it changed at
+type NFT struct {
+	DenomID string `json:"denom_id"`
+	TokenID string `json:"token_id"`
+	Name    string `json:"name"`
+	URI     string `json:"uri"`
+	URIHash string `json:"uri_hash"`
+	Data    string `json:"data"`
+	Owner   string `json:"owner"`
+	Height  int64  `json:"height"`
+}
+
+// Transfer is an entry of the history of a NFT, the sender of a mint and the
+// recipient of a burn are empty
+type Transfer struct {
+	Height    int64  `json:"height"`
+	TxHash    string `json:"tx_hash"`
+	DenomID   string `json:"denom_id"`
+	TokenID   string `json:"token_id"`
+	Action    string `json:"action"`
+	Sender    string `json:"sender"`
+	Recipient string `json:"recipient"`
+}
+
+// NFTFilter selects the NFTs returned by a search, the empty fields match any
+// NFT
+type NFTFilter struct {
+	// Name matches the NFTs whose name contains it, ignoring the case
+	Name    string
+	DenomID string
+	Owner   string
+	Limit   int
+	Offset  int
+}
+
+// Store is the SQLite database of the indexer
+type Store struct {
+	db *sql.DB
+}
+
+// OpenStore opens the database at path and creates its schema when missing
+func OpenStore(path string) (*Store, error) {
+	db, err := sql.Open("sqlite3", fmt.Sprintf("file:%s?_journal_mode=WAL&_busy_timeout=5000&_foreign_keys=on", path))
+	if err != nil {
+		return nil, err
+	}
+	// a single connection serializes the writes of the indexer with the reads
+	// of the API, SQLite doesn't allow concurrent writers anyway
+	db.SetMaxOpenConns(1)
+
+	if _, err := db.Exec(schema); err != nil {
+		db.Close() // nolint: errcheck
+		return nil, err
+	}
+	return &Store{db: db}, nil
+}
+
+// Close closes the database
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Height returns the last indexed height, zero if nothing is indexed yet
+func (s *Store) Height() (int64, error) {
+	var height int64
+	err := s.db.QueryRow(`SELECT height FROM state WHERE id = 0`).Scan(&height)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	return height, err
+}
+
+// Update runs fn in a transaction which also records the height as indexed,
+// the changes of a block are thus either fully indexed or not at all
+func (s *Store) Update(height int64, fn func(*Batch) error) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() // nolint: errcheck
+
+	if err := fn(&Batch{tx: tx, height: height}); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(
+		`INSERT INTO state (id, height) VALUES (0, ?) ON CONFLICT (id) DO UPDATE SET height = excluded.height`,
+		height,
+	); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// Batch writes the changes of a block
+type Batch struct {
+	tx     *sql.Tx
+	height int64
+}
+
+// SetDenom inserts or replaces the denom
+func (b *Batch) SetDenom(denom Denom) error {
+	_, err := b.tx.Exec(
+		`INSERT OR REPLACE INTO denoms (id, name, schema, creator, uri, uri_hash, paused, height) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		denom.ID, denom.Name, denom.Schema, denom.Creator, denom.URI, denom.URIHash, denom.Paused, b.height,
+	)
+	return err
+}
+
+// SetPaused pauses or unpauses the denom
+func (b *Batch) SetPaused(denomID string, paused bool) error {
+	_, err := b.tx.Exec(`UPDATE denoms SET paused = ?, height = ? WHERE id = ?`, paused, b.height, denomID)
+	return err
+}
+
+// SetCreator replaces the creator of the denom
+func (b *Batch) SetCreator(denomID, creator string) error {
+	_, err := b.tx.Exec(`UPDATE denoms SET creator = ?, height = ? WHERE id = ?`, creator, b.height, denomID)
+	return err
+}
+
+// SetNFT inserts or replaces the NFT
+func (b *Batch) SetNFT(nft NFT) error {
+	_, err := b.tx.Exec(
+		`INSERT OR REPLACE INTO nfts (denom_id, token_id, name, uri, uri_hash, data, owner, height) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		nft.DenomID, nft.TokenID, nft.Name, nft.URI, nft.URIHash, nft.Data, nft.Owner, b.height,
+	)
+	return err
+}
+
+// nftColumns are the fields of the NFT updated by UpdateNFT, named after
+// the field changes of the events
+var nftColumns = map[string]bool{
+	"name":     true,
+	"uri":      true,
+	"uri_hash": true,
+	"data":     true,
+	"owner":    true,
+}
+
+// UpdateNFT sets the fields of the NFT, the fields not indexed are skipped
+func (b *Batch) UpdateNFT(denomID, tokenID string, fields map[string]string) error {
+	var (
+		sets []string
+		args []interface{}
+	)
+	for field, value := range fields {
+		if !nftColumns[field] {
+			continue
+		}
+		sets = append(sets, field+" = ?")
+		args = append(args, value)
+	}
+	sets = append(sets, "height = ?")
+	args = append(args, b.height, denomID, tokenID)
+
+	_, err := b.tx.Exec(
+		fmt.Sprintf(`UPDATE nfts SET %s WHERE denom_id = ? AND token_id = ?`, strings.Join(sets, ", ")),
+		args...,
+	)
+	return err
+}
+
+// DeleteNFT deletes the NFT, its history is kept
+func (b *Batch) DeleteNFT(denomID, tokenID string) error {
+	_, err := b.tx.Exec(`DELETE FROM nfts WHERE denom_id = ? AND token_id = ?`, denomID, tokenID)
+	return err
+}
+
+// AddTransfer appends the entry to the history of the NFT
+func (b *Batch) AddTransfer(transfer Transfer) error {
+	_, err := b.tx.Exec(
+		`INSERT INTO transfers (height, tx_hash, denom_id, token_id, action, sender, recipient) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		b.height, transfer.TxHash, transfer.DenomID, transfer.TokenID, transfer.Action, transfer.Sender, transfer.Recipient,
+	)
+	return err
+}
+
+// Denoms returns the denoms ordered by id
+func (s *Store) Denoms(limit, offset int) ([]Denom, error) {
+	rows, err := s.db.Query(
+		`SELECT id, name, schema, creator, uri, uri_hash, paused, height FROM denoms ORDER BY id LIMIT ? OFFSET ?`,
+		limit, offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	denoms := []Denom{}
+	for rows.Next() {
+		var denom Denom
+		if err := rows.Scan(&denom.ID, &denom.Name, &denom.Schema, &denom.Creator, &denom.URI, &denom.URIHash, &denom.Paused, &denom.Height); err != nil {
+			return nil, err
+		}
+		denoms = append(denoms, denom)
+	}
+	return denoms, rows.Err()
+}
+
+// Denom returns the denom, nil if it isn't indexed
+func (s *Store) Denom(id string) (*Denom, error) {
+	var denom Denom
+	err := s.db.QueryRow(
+		`SELECT id, name, schema, creator, uri, uri_hash, paused, height FROM denoms WHERE id = ?`, id,
+	).Scan(&denom.ID, &denom.Name, &denom.Schema, &denom.Creator, &denom.URI, &denom.URIHash, &denom.Paused, &denom.Height)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &denom, nil
+}
+
+// NFT returns the NFT, nil if it isn't indexed
+func (s *Store) NFT(denomID, tokenID string) (*NFT, error) {
+	nfts, err := s.queryNFTs(
+		`SELECT denom_id, token_id, name, uri, uri_hash, data, owner, height FROM nfts WHERE denom_id = ? AND token_id = ?`,
+		denomID, tokenID,
+	)
+	if err != nil || len(nfts) == 0 {
+		return nil, err
+	}
+	return &nfts[0], nil
+}
+
+// NFTs returns the NFTs matching the filter ordered by denom and token id
+func (s *Store) NFTs(filter NFTFilter) ([]NFT, error) {
+	var (
+		where []string
+		args  []interface{}
+	)
+	if len(filter.Name) > 0 {
+		where = append(where, `name LIKE ? ESCAPE '\'`)
+		args = append(args, "%"+escapeLike(filter.Name)+"%")
+	}
+	if len(filter.DenomID) > 0 {
+		where = append(where, "denom_id = ?")
+		args = append(args, filter.DenomID)
+	}
+	if len(filter.Owner) > 0 {
+		where = append(where, "owner = ?")
+		args = append(args, filter.Owner)
+	}
+
+	query := `SELECT denom_id, token_id, name, uri, uri_hash, data, owner, height FROM nfts`
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += " ORDER BY denom_id, token_id LIMIT ? OFFSET ?"
+	args = append(args, filter.Limit, filter.Offset)
+
+	return s.queryNFTs(query, args...)
+}
+
+func (s *Store) queryNFTs(query string, args ...interface{}) ([]NFT, error) {
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	nfts := []NFT{}
+	for rows.Next() {
+		var nft NFT
+		if err := rows.Scan(&nft.DenomID, &nft.TokenID, &nft.Name, &nft.URI, &nft.URIHash, &nft.Data, &nft.Owner, &nft.Height); err != nil {
+			return nil, err
+		}
+		nfts = append(nfts, nft)
+	}
+	return nfts, rows.Err()
+}
+
+// TokenHistory returns the transfers of the NFT, the most recent first
+func (s *Store) TokenHistory(denomID, tokenID string, limit, offset int) ([]Transfer, error) {
+	return s.history("denom_id = ? AND token_id = ?", limit, offset, denomID, tokenID)
+}
+
+// AccountHistory returns the transfers sent or received by the account, the
+// most recent first
+func (s *Store) AccountHistory(account string, limit, offset int) ([]Transfer, error) {
+	return s.history("sender = ? OR recipient = ?", limit, offset, account, account)
+}
+
+func (s *Store) history(where string, limit, offset int, args ...interface{}) ([]Transfer, error) {
+	rows, err := s.db.Query(
+		`SELECT height, tx_hash, denom_id, token_id, action, sender, recipient FROM transfers WHERE `+where+` ORDER BY id DESC LIMIT ? OFFSET ?`,
+		append(args, limit, offset)...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	transfers := []Transfer{}
+	for rows.Next() {
+		var t Transfer
+		if err := rows.Scan(&t.Height, &t.TxHash, &t.DenomID, &t.TokenID, &t.Action, &t.Sender, &t.Recipient); err != nil {
+			return nil, err
+		}
+		transfers = append(transfers, t)
+	}
+	return transfers, rows.Err()
+}
+
+// escapeLike escapes the wildcards of a LIKE pattern
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -0,0 +1,15 @@
+// nftd runs a node of the application of the nft module. Next to the commands
+// of the SDK, its root command offers the nft genesis commands building the
+// nft section of a genesis file offline.
+package main
+
+import (
+	"os"
+)
+
+func main() {
+	rootCmd, _ := NewRootCmd()
+	if err := Execute(rootCmd); err != nil {
+		os.Exit(1)
+	}
+}
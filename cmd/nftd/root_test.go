@@ -0,0 +1,61 @@
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	simapp "github.com/irismod/nft/app"
+	"github.com/irismod/nft/types"
+)
+
+var (
+	alice = sdk.AccAddress("alice_______________")
+	bob   = sdk.AccAddress("bob_________________")
+)
+
+// run executes the arguments on a new root command
+func run(t *testing.T, args ...string) error {
+	rootCmd, _ := NewRootCmd()
+	rootCmd.SetArgs(args)
+	rootCmd.SetOut(ioutil.Discard)
+	rootCmd.SetErr(ioutil.Discard)
+	return Execute(rootCmd)
+}
+
+func TestAddGenesisCommands(t *testing.T) {
+	home := t.TempDir()
+	require.NoError(t, run(t, "init", "nftd-test", "--chain-id=test", "--home="+home))
+
+	require.NoError(t, run(t, "add-genesis-denom", "kitty", alice.String(), "--name=Kitties", "--home="+home))
+	require.NoError(t, run(t, "add-genesis-nft", "kitty", "kitty1", bob.String(), "--name=Tom", "--home="+home))
+
+	holders := filepath.Join(home, "holders.csv")
+	require.NoError(t, ioutil.WriteFile(holders, []byte("token_id,owner\npunk1,"+alice.String()+"\n"), 0600))
+	require.NoError(t, run(t, "add-genesis-collection", "erc721", holders, "punk", bob.String(),
+		"--report="+filepath.Join(home, "report.json"), "--home="+home))
+
+	// an invalid entry leaves the genesis file untouched
+	require.Error(t, run(t, "add-genesis-nft", "kitty", "kitty1", alice.String(), "--home="+home))
+	require.NoError(t, run(t, "validate-nft-genesis", filepath.Join(home, "config", "genesis.json")))
+
+	appState, _, err := genutiltypes.GenesisStateFromGenFile(filepath.Join(home, "config", "genesis.json"))
+	require.NoError(t, err)
+	var data types.GenesisState
+	require.NoError(t, simapp.MakeEncodingConfig().Marshaler.UnmarshalJSON(appState[types.ModuleName], &data))
+	require.NoError(t, types.ValidateGenesis(data))
+
+	require.Len(t, data.Collections, 2)
+	require.Equal(t, "kitty", data.Collections[0].Denom.Id)
+	require.Equal(t, "Kitties", data.Collections[0].Denom.Name)
+	require.Len(t, data.Collections[0].NFTs, 1)
+	require.Equal(t, bob, data.Collections[0].NFTs[0].Owner)
+	require.Equal(t, "punk", data.Collections[1].Denom.Id)
+	require.Len(t, data.Collections[1].NFTs, 1)
+	require.Equal(t, alice, data.Collections[1].NFTs[0].Owner)
+}
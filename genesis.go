@@ -1,65 +1,273 @@
 package nft
 
 import (
-	"unicode/utf8"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
 
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/irismod/nft/keeper"
 	"github.com/irismod/nft/types"
 )
 
-// InitGenesis sets nft information for genesis.
+// InitGenesis sets nft information for genesis. The denom and token ids are
+// lowercased like the ones of the messages and of the v2 store migration.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 	if err := ValidateGenesis(data); err != nil {
 		panic(err.Error())
 	}
+	if err := types.ValidateGenesisPolicies(data, k.HasTransferPolicy); err != nil {
+		panic(err.Error())
+	}
+
+	k.SetParams(ctx, data.Params)
+	k.SetConsensusVersion(ctx, ConsensusVersion)
+
+	// ensure the module account holding the storage deposits is set
+	if moduleAcc := k.GetDepositAccount(ctx); moduleAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	// the NFTs whose history is imported aren't recorded as minted again
+	withHistory := make(map[string]map[string]bool)
+	for _, history := range data.Histories {
+		denomID := strings.ToLower(history.DenomId)
+		if withHistory[denomID] == nil {
+			withHistory[denomID] = make(map[string]bool)
+		}
+		withHistory[denomID][strings.ToLower(history.TokenId)] = true
+	}
 
 	for _, c := range data.Collections {
-		if err := k.SetDenom(ctx, c.Denom); err != nil {
+		collection := types.Collection{Denom: c.Denom, NFTs: make([]types.BaseNFT, len(c.NFTs))}
+		collection.Denom.Id = strings.ToLower(c.Denom.Id)
+		for i, nft := range c.NFTs {
+			nft.Id = strings.ToLower(nft.Id)
+			collection.NFTs[i] = nft
+		}
+
+		if err := k.SetDenom(ctx, collection.Denom); err != nil {
+			panic(err)
+		}
+		if err := k.ImportCollection(ctx, collection, withHistory[collection.Denom.Id]); err != nil {
 			panic(err)
 		}
-		if err := k.SetCollection(ctx, c); err != nil {
+	}
+
+	for _, history := range data.Histories {
+		history.DenomId = strings.ToLower(history.DenomId)
+		history.TokenId = strings.ToLower(history.TokenId)
+		k.SetTokenHistory(ctx, history)
+	}
+
+	for _, deposit := range data.Deposits {
+		deposit.DenomId = strings.ToLower(deposit.DenomId)
+		deposit.TokenId = strings.ToLower(deposit.TokenId)
+		k.SetDeposit(ctx, deposit)
+	}
+
+	for _, hidden := range data.Hidden {
+		if err := k.SetHidden(ctx, strings.ToLower(hidden.DenomId), strings.ToLower(hidden.TokenId), true); err != nil {
 			panic(err)
 		}
 	}
+
+	for _, denomID := range data.PausedDenoms {
+		k.SetDenomPaused(ctx, strings.ToLower(denomID), true)
+	}
+
+	for _, policy := range data.Policies {
+		if err := k.SetDenomTransferPolicy(ctx, strings.ToLower(policy.DenomId), policy.Policy); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, entry := range data.PolicyAddresses {
+		address, err := sdk.AccAddressFromBech32(entry.Address)
+		if err != nil {
+			panic(err)
+		}
+		k.SetListed(ctx, strings.ToLower(entry.DenomId), entry.List, address, true)
+	}
+
+	for _, grant := range data.Grants {
+		granter, err := sdk.AccAddressFromBech32(grant.Granter)
+		if err != nil {
+			panic(err)
+		}
+		grantee, err := sdk.AccAddressFromBech32(grant.Grantee)
+		if err != nil {
+			panic(err)
+		}
+		k.SetGrant(ctx, granter, grantee, types.Grant{Authorization: grant.Authorization, Expiration: grant.Expiration})
+	}
 }
 
-// ExportGenesis returns a GenesisState for a given context and keeper.
+// ExportGenesis returns a GenesisState for a given context and keeper. It holds
+// every NFT in memory, AppModule.ExportGenesis uses WriteGenesis instead.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
-	return types.NewGenesisState(k.GetCollections(ctx))
+	return types.NewGenesisState(k.GetParams(ctx), k.GetCollections(ctx), k.GetTokenHistories(ctx), k.GetDeposits(ctx), k.GetHiddens(ctx), k.GetPausedDenoms(ctx),
+		k.GetTransferPolicies(ctx), k.GetPolicyAddresses(ctx), k.GetGrantAuthorizations(ctx))
 }
 
-// DefaultGenesisState returns a default genesis state
-func DefaultGenesisState() *types.GenesisState {
-	return types.NewGenesisState([]types.Collection{})
-}
+// WriteGenesis writes the JSON encoding of the GenesisState to w like
+// ExportGenesis followed by cdc.MarshalJSON, except that the NFTs and the
+// histories, deposits, hidden flags and grants are read from the store and
+// encoded one at a time, so that at most one of them is held in memory.
+func WriteGenesis(ctx sdk.Context, k keeper.Keeper, cdc codec.JSONMarshaler, w io.Writer) error {
+	// the other fields are encoded as a whole, the streamed ones are then
+	// written in place of their empty lists of the encoding
+	rest := types.NewGenesisState(k.GetParams(ctx), nil, nil, nil, nil, k.GetPausedDenoms(ctx),
+		k.GetTransferPolicies(ctx), k.GetPolicyAddresses(ctx), nil)
+	bz, err := cdc.MarshalJSON(rest)
+	if err != nil {
+		return err
+	}
 
-// ValidateGenesis performs basic validation of nfts genesis data returning an
-// error for any failed validation criteria.
-func ValidateGenesis(data types.GenesisState) error {
-	for _, c := range data.Collections {
-		if err := types.ValidateDenomID(c.Denom.Name); err != nil {
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	if _, err := decoder.Token(); err != nil {
+		return err
+	}
+	ew := &errWriter{w: w}
+	ew.write("{")
+	for i := 0; decoder.More(); i++ {
+		key, err := decoder.Token()
+		if err != nil {
 			return err
 		}
-		if !utf8.ValidString(c.Denom.Name) {
-			return sdkerrors.Wrap(types.ErrInvalidDenom, "denom name is invalid")
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return err
 		}
 
-		for _, nft := range c.NFTs {
-			if nft.GetOwner().Empty() {
-				return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing owner")
-			}
+		name, err := json.Marshal(key)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			ew.write(",")
+		}
+		ew.write(string(name) + ":")
 
-			if err := types.ValidateTokenID(nft.GetID()); err != nil {
-				return err
-			}
+		switch key {
+		case "collections":
+			err = writeCollections(ctx, k, cdc, ew)
+		case "histories":
+			err = writeArray(cdc, ew, func(emit func(proto.Message) bool) {
+				k.IterateTokenHistories(ctx, func(history types.TokenHistory) bool { return emit(&history) })
+			})
+		case "deposits":
+			err = writeArray(cdc, ew, func(emit func(proto.Message) bool) {
+				k.IterateDeposits(ctx, func(deposit types.TokenDeposit) bool { return emit(&deposit) })
+			})
+		case "hidden":
+			err = writeArray(cdc, ew, func(emit func(proto.Message) bool) {
+				k.IterateHiddens(ctx, func(hidden types.Hidden) bool { return emit(&hidden) })
+			})
+		case "grants":
+			err = writeArray(cdc, ew, func(emit func(proto.Message) bool) {
+				k.IterateGrants(ctx, func(grant types.GrantAuthorization) bool { return emit(&grant) })
+			})
+		default:
+			ew.write(string(value))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	ew.write("}")
+	return ew.err
+}
 
-			if err := types.ValidateTokenURI(nft.GetURI()); err != nil {
-				return err
-			}
+// writeArray writes the messages passed to emit by iterate as a JSON array,
+// emit returning true once the writes failed
+func writeArray(cdc codec.JSONMarshaler, ew *errWriter, iterate func(emit func(msg proto.Message) (stop bool))) (err error) {
+	ew.write("[")
+	first := true
+	iterate(func(msg proto.Message) bool {
+		var bz []byte
+		if bz, err = cdc.MarshalJSON(msg); err != nil {
+			return true
+		}
+		if !first {
+			ew.write(",")
+		}
+		first = false
+		ew.write(string(bz))
+		return ew.err != nil
+	})
+	if err != nil {
+		return err
+	}
+	ew.write("]")
+	return ew.err
+}
+
+// writeCollections writes the collections as a JSON array, the NFTs of each
+// denom being streamed from the store
+func writeCollections(ctx sdk.Context, k keeper.Keeper, cdc codec.JSONMarshaler, ew *errWriter) (err error) {
+	ew.write("[")
+	first := true
+	k.IterateDenoms(ctx, func(denom types.Denom) bool {
+		var bz []byte
+		if bz, err = cdc.MarshalJSON(&denom); err != nil {
+			return true
+		}
+		if !first {
+			ew.write(",")
 		}
+		first = false
+		ew.write(`{"denom":` + string(bz) + `,"nfts":[`)
+
+		firstNFT := true
+		k.IterateNFTs(ctx, denom.Id, func(nft types.BaseNFT) bool {
+			if bz, err = cdc.MarshalJSON(&nft); err != nil {
+				return true
+			}
+			if !firstNFT {
+				ew.write(",")
+			}
+			firstNFT = false
+			ew.write(string(bz))
+			return ew.err != nil
+		})
+		ew.write("]}")
+		return err != nil || ew.err != nil
+	})
+	if err != nil {
+		return err
 	}
-	return nil
+	ew.write("]")
+	return ew.err
+}
+
+// errWriter keeps the first error of its writes, the following writes being
+// skipped
+type errWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (ew *errWriter) write(s string) {
+	if ew.err == nil {
+		_, ew.err = io.WriteString(ew.w, s)
+	}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() *types.GenesisState {
+	return types.NewGenesisState(types.DefaultParams(), []types.Collection{}, []types.TokenHistory{}, []types.TokenDeposit{}, []types.Hidden{}, []string{},
+		[]types.DenomTransferPolicy{}, []types.PolicyAddress{}, []types.GrantAuthorization{})
+}
+
+// ValidateGenesis performs basic validation of nfts genesis data returning an
+// error listing every failed validation criteria.
+func ValidateGenesis(data types.GenesisState) error {
+	return types.ValidateGenesis(data)
 }
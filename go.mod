@@ -3,11 +3,15 @@ module github.com/irismod/nft
 go 1.14
 
 require (
+	github.com/armon/go-metrics v0.3.4
 	github.com/cosmos/cosmos-sdk v0.34.4-0.20200914022129-c26ef79ed0a2
 	github.com/gogo/protobuf v1.3.1
 	github.com/golang/protobuf v1.4.2
 	github.com/gorilla/mux v1.8.0
 	github.com/grpc-ecosystem/grpc-gateway v1.14.8
+	github.com/mattn/go-sqlite3 v1.14.6
+	github.com/regen-network/cosmos-proto v0.3.0
+	github.com/spf13/cast v1.3.1
 	github.com/spf13/cobra v1.0.0
 	github.com/spf13/pflag v1.0.5
 	github.com/spf13/viper v1.7.1
@@ -16,6 +20,7 @@ require (
 	github.com/tendermint/tm-db v0.6.2
 	google.golang.org/genproto v0.0.0-20200825200019-8632dd797987
 	google.golang.org/grpc v1.32.0
+	gopkg.in/yaml.v2 v2.3.0
 )
 
 replace github.com/gogo/protobuf => github.com/regen-network/protobuf v1.3.2-alpha.regen.4
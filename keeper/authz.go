@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irismod/nft/types"
+)
+
+// GrantAuthorization grants the authorization to the grantee until the
+// expiration, replacing the grant of the same message type if any
+func (k Keeper) GrantAuthorization(ctx sdk.Context,
+	granter, grantee sdk.AccAddress,
+	authorization types.Authorization,
+	expiration time.Time) error {
+	if !expiration.After(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrInvalidGrant, "expiration %s is not after the block time", expiration)
+	}
+
+	grant, err := types.NewGrant(authorization, expiration)
+	if err != nil {
+		return err
+	}
+	k.SetGrant(ctx, granter, grantee, grant)
+	return nil
+}
+
+// RevokeAuthorization deletes the grant of the message type
+func (k Keeper) RevokeAuthorization(ctx sdk.Context, granter, grantee sdk.AccAddress, msgTypeURL string) error {
+	if _, found := k.GetGrant(ctx, granter, grantee, msgTypeURL); !found {
+		return sdkerrors.Wrapf(types.ErrInvalidGrant, "no authorization of %s granted to %s by %s", msgTypeURL, grantee, granter)
+	}
+	k.deleteGrant(ctx, granter, grantee, msgTypeURL)
+	return nil
+}
+
+// SetGrant stores the grant under the message type of its authorization
+func (k Keeper) SetGrant(ctx sdk.Context, granter, grantee sdk.AccAddress, grant types.Grant) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyGrant(granter, grantee, grant.GetAuthorization().MsgTypeURL()), k.cdc.MustMarshalBinaryBare(&grant))
+}
+
+// GetGrant returns the grant of the message type, expired or not
+func (k Keeper) GetGrant(ctx sdk.Context, granter, grantee sdk.AccAddress, msgTypeURL string) (grant types.Grant, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyGrant(granter, grantee, msgTypeURL))
+	if len(bz) == 0 {
+		return grant, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+	return grant, true
+}
+
+// GetGrants returns the grants given by the granter to the grantee
+func (k Keeper) GetGrants(ctx sdk.Context, granter, grantee sdk.AccAddress) (grants []types.Grant) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyGrant(granter, grantee, ""))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.Grant
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &grant)
+		grants = append(grants, grant)
+	}
+	return grants
+}
+
+// IterateGrants iterates over every grant in the order of their granters
+// until the callback returns true
+func (k Keeper) IterateGrants(ctx sdk.Context, cb func(grant types.GrantAuthorization) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyGrant(nil, nil, ""))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		granter, grantee, _, err := types.SplitKeyGrant(iterator.Key())
+		if err != nil {
+			continue
+		}
+
+		var grant types.Grant
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &grant)
+		if cb(types.NewGrantAuthorization(granter, grantee, grant)) {
+			break
+		}
+	}
+}
+
+// GetGrantAuthorizations returns every grant with its granter and grantee
+func (k Keeper) GetGrantAuthorizations(ctx sdk.Context) (grants []types.GrantAuthorization) {
+	k.IterateGrants(ctx, func(grant types.GrantAuthorization) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	return grants
+}
+
+func (k Keeper) deleteGrant(ctx sdk.Context, granter, grantee sdk.AccAddress, msgTypeURL string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyGrant(granter, grantee, msgTypeURL))
+}
+
+// acceptGrant checks that the grantee holds an unexpired authorization of the
+// granter accepting the message, and stores the authorization as updated by
+// its acceptance. An expired grant is kept until it is revoked or replaced.
+func (k Keeper) acceptGrant(ctx sdk.Context, granter, grantee sdk.AccAddress, msg sdk.Msg) error {
+	msgTypeURL := types.MsgTypeURL(msg)
+	grant, found := k.GetGrant(ctx, granter, grantee, msgTypeURL)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "no authorization of %s granted to %s by %s", msgTypeURL, grantee, granter)
+	}
+	if !grant.Expiration.After(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "authorization of %s granted to %s by %s expired", msgTypeURL, grantee, granter)
+	}
+
+	authorization := grant.GetAuthorization()
+	if authorization == nil {
+		return sdkerrors.Wrapf(types.ErrInvalidGrant, "authorization of %s can't be unpacked", msgTypeURL)
+	}
+	res, err := authorization.Accept(ctx, msg)
+	if err != nil {
+		return err
+	}
+	if !res.Accept {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "authorization of %s granted to %s by %s rejected the message", msgTypeURL, grantee, granter)
+	}
+
+	switch {
+	case res.Delete:
+		k.deleteGrant(ctx, granter, grantee, msgTypeURL)
+	case res.Updated != nil:
+		updated, err := types.NewGrant(res.Updated, grant.Expiration)
+		if err != nil {
+			return err
+		}
+		k.SetGrant(ctx, granter, grantee, updated)
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irismod/nft/keeper"
+	"github.com/irismod/nft/types"
+)
+
+func (suite *KeeperSuite) TestExecAuthorized() {
+	ctx := suite.ctx.WithBlockTime(time.Now())
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	expiration := ctx.BlockTime().Add(time.Hour)
+
+	grant := func(authorization types.Authorization) {
+		msg, err := types.NewMsgGrantAuthorization(address.String(), address2.String(), authorization, expiration)
+		suite.NoError(err)
+		suite.NoError(msg.ValidateBasic())
+		_, err = msgServer.GrantAuthorization(sdk.WrapSDKContext(ctx), msg)
+		suite.NoError(err)
+	}
+	exec := func(ctx sdk.Context, msgs ...sdk.Msg) error {
+		msg, err := types.NewMsgExecAuthorized(address2.String(), msgs)
+		suite.NoError(err)
+		suite.NoError(msg.ValidateBasic())
+		_, err = msgServer.ExecAuthorized(sdk.WrapSDKContext(ctx), msg)
+		return err
+	}
+	mint := func(tokenID string) sdk.Msg {
+		return types.NewMsgMintNFT(tokenID, denomID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address.String(), address.String())
+	}
+
+	// a message of the granter can't be executed without a grant
+	suite.Error(exec(ctx, mint(tokenID)))
+
+	// the mint grant is deleted once its spend limit is reached
+	grant(types.NewMintAuthorization(denomID, 2))
+	suite.NoError(exec(ctx, mint(tokenID), mint(tokenID2)))
+	suite.True(suite.keeper.HasNFT(ctx, denomID, tokenID2))
+	_, found := suite.keeper.GetGrant(ctx, address, address2, types.MsgTypeURL(&types.MsgMintNFT{}))
+	suite.False(found)
+	suite.Error(exec(ctx, mint(tokenID3)))
+
+	// the transfer grant only accepts the listed tokens
+	grant(types.NewTransferAuthorization(denomID, []string{tokenID}))
+	transfer := func(tokenID string) sdk.Msg {
+		return types.NewMsgTransferNFT(tokenID, denomID, types.DoNotModify, types.DoNotModify, types.DoNotModify, types.DoNotModify, address.String(), address3.String())
+	}
+	suite.Error(exec(ctx, transfer(tokenID2)))
+	suite.NoError(exec(ctx, transfer(tokenID)))
+	nft, err := suite.keeper.GetNFT(ctx, denomID, tokenID)
+	suite.NoError(err)
+	suite.Equal(address3, nft.GetOwner())
+
+	// the messages signed by the grantee are executed without a grant
+	suite.NoError(exec(ctx, types.NewMsgMintNFT(tokenID3, denomID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address2.String(), address2.String())))
+
+	// an expired grant is rejected
+	grant(types.NewBurnAuthorization(denomID, nil))
+	burn := types.NewMsgBurnNFT(address.String(), tokenID2, denomID)
+	suite.Error(exec(ctx.WithBlockTime(expiration), burn))
+
+	// a revoked grant is rejected
+	grant(types.NewBurnAuthorization(denomID, nil))
+	_, err = msgServer.RevokeAuthorization(sdk.WrapSDKContext(ctx), types.NewMsgRevokeAuthorization(address.String(), address2.String(), types.MsgTypeURL(burn)))
+	suite.NoError(err)
+	suite.Error(exec(ctx, burn))
+	suite.True(suite.keeper.HasNFT(ctx, denomID, tokenID2))
+}
+
+func (suite *KeeperSuite) TestGrantAuthorization() {
+	ctx := suite.ctx.WithBlockTime(time.Now())
+	authorization := types.NewEditAuthorization(denomID, nil)
+
+	// a grant must expire after the block time
+	suite.Error(suite.keeper.GrantAuthorization(ctx, address, address2, authorization, ctx.BlockTime()))
+	suite.NoError(suite.keeper.GrantAuthorization(ctx, address, address2, authorization, ctx.BlockTime().Add(time.Hour)))
+
+	grants := suite.keeper.GetGrants(ctx, address, address2)
+	suite.Len(grants, 1)
+	suite.Equal(authorization, grants[0].GetAuthorization())
+	suite.Empty(suite.keeper.GetGrants(ctx, address2, address))
+
+	suite.Len(suite.keeper.GetGrantAuthorizations(ctx), 1)
+	suite.Error(suite.keeper.RevokeAuthorization(ctx, address, address2, types.MsgTypeURL(&types.MsgBurnNFT{})))
+	suite.NoError(suite.keeper.RevokeAuthorization(ctx, address, address2, authorization.MsgTypeURL()))
+	suite.Empty(suite.keeper.GetGrantAuthorizations(ctx))
+}
@@ -0,0 +1,183 @@
+package keeper_test
+
+import (
+	"fmt"
+	"runtime"
+	"testing"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irismod/nft"
+	simapp "github.com/irismod/nft/app"
+	"github.com/irismod/nft/keeper"
+	"github.com/irismod/nft/types"
+)
+
+const (
+	benchDenoms = 10
+	benchOwners = 1000
+)
+
+// benchSizes are the numbers of NFTs of the benchmarks, the largest one is
+// skipped with -short as minting it takes a few minutes
+var benchSizes = []int{10000, 100000, 1000000}
+
+var benchStates = make(map[int]benchState)
+
+type benchState struct {
+	app *simapp.SimApp
+	ctx sdk.Context
+}
+
+// setupBench mints size NFTs spread over benchDenoms denoms and benchOwners
+// owners and commits them, so that the benchmarks iterate the IAVL store
+// instead of a cache
+func setupBench(b *testing.B, size int) (sdk.Context, keeper.Keeper) {
+	if state, ok := benchStates[size]; ok {
+		return state.ctx, state.app.NFTKeeper
+	}
+
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	owners := make([]sdk.AccAddress, benchOwners)
+	for i := range owners {
+		owners[i] = sdk.AccAddress(fmt.Sprintf("bench-owner-%08d", i))
+	}
+
+	for d := 0; d < benchDenoms; d++ {
+		denom := fmt.Sprintf("denom%d", d)
+		if err := app.NFTKeeper.IssueDenom(ctx, denom, denom, schema, "", "", "", 0, false, address); err != nil {
+			b.Fatal(err)
+		}
+	}
+	for i := 0; i < size; i++ {
+		denom := fmt.Sprintf("denom%d", i%benchDenoms)
+		owner := owners[i%benchOwners]
+		if err := app.NFTKeeper.MintNFT(ctx, denom, fmt.Sprintf("token%d", i), tokenNm, tokenURI, tokenURIHash, tokenData, nil, owner, owner); err != nil {
+			b.Fatal(err)
+		}
+	}
+	app.Commit()
+
+	state := benchState{app: app, ctx: app.BaseApp.NewContext(true, tmproto.Header{})}
+	benchStates[size] = state
+	return state.ctx, app.NFTKeeper
+}
+
+// runBench runs fn over every state size and reports, besides the allocations,
+// the heap still held by the value returned by fn once the garbage is collected
+func runBench(b *testing.B, fn func(b *testing.B, ctx sdk.Context, k keeper.Keeper) interface{}) {
+	for _, size := range benchSizes {
+		b.Run(fmt.Sprintf("nfts=%d", size), func(b *testing.B) {
+			if testing.Short() && size >= 1000000 {
+				b.Skip("skipping the largest state with -short")
+			}
+			ctx, k := setupBench(b, size)
+
+			var before, after runtime.MemStats
+			var live uint64
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				runtime.GC()
+				runtime.ReadMemStats(&before)
+				b.StartTimer()
+
+				result := fn(b, ctx, k)
+
+				b.StopTimer()
+				runtime.GC()
+				runtime.ReadMemStats(&after)
+				if after.HeapAlloc > before.HeapAlloc {
+					live += after.HeapAlloc - before.HeapAlloc
+				}
+				runtime.KeepAlive(result)
+				b.StartTimer()
+			}
+			b.ReportMetric(float64(live)/float64(b.N), "live-B/op")
+		})
+	}
+}
+
+func BenchmarkIterateNFTs(b *testing.B) {
+	runBench(b, func(b *testing.B, ctx sdk.Context, k keeper.Keeper) interface{} {
+		count := 0
+		k.IterateDenoms(ctx, func(denom types.Denom) bool {
+			k.IterateNFTs(ctx, denom.Id, func(types.BaseNFT) bool {
+				count++
+				return false
+			})
+			return false
+		})
+		if count == 0 {
+			b.Fatal("no nft iterated")
+		}
+		return count
+	})
+}
+
+func BenchmarkIterateOwners(b *testing.B) {
+	runBench(b, func(b *testing.B, ctx sdk.Context, k keeper.Keeper) interface{} {
+		count := 0
+		k.IterateOwners(ctx, func(sdk.AccAddress, string, string) bool {
+			count++
+			return false
+		})
+		if count == 0 {
+			b.Fatal("no owner iterated")
+		}
+		return count
+	})
+}
+
+func BenchmarkGetOwners(b *testing.B) {
+	runBench(b, func(b *testing.B, ctx sdk.Context, k keeper.Keeper) interface{} {
+		owners := k.GetOwners(ctx)
+		if len(owners) == 0 {
+			b.Fatal("no owner found")
+		}
+		return owners
+	})
+}
+
+func BenchmarkSupplyInvariant(b *testing.B) {
+	runBench(b, func(b *testing.B, ctx sdk.Context, k keeper.Keeper) interface{} {
+		msg, broken := keeper.SupplyInvariant(k)(ctx)
+		if broken {
+			b.Fatal(msg)
+		}
+		return msg
+	})
+}
+
+func BenchmarkWriteGenesis(b *testing.B) {
+	runBench(b, func(b *testing.B, ctx sdk.Context, k keeper.Keeper) interface{} {
+		var w countWriter
+		if err := nft.WriteGenesis(ctx, k, simapp.MakeEncodingConfig().Marshaler, &w); err != nil {
+			b.Fatal(err)
+		}
+		return w
+	})
+}
+
+// countWriter discards the bytes written, so that WriteGenesis is measured
+// without the buffer of its output
+type countWriter int
+
+func (w *countWriter) Write(p []byte) (int, error) {
+	*w += countWriter(len(p))
+	return len(p), nil
+}
+
+func BenchmarkExportGenesis(b *testing.B) {
+	runBench(b, func(b *testing.B, ctx sdk.Context, k keeper.Keeper) interface{} {
+		genesis := nft.ExportGenesis(ctx, k)
+		if len(genesis.Collections) != benchDenoms {
+			b.Fatal("missing collections")
+		}
+		return genesis
+	})
+}
@@ -1,21 +1,42 @@
 package keeper
 
 import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/irismod/nft/types"
 )
 
-// SetCollection save all NFT and return error if existed
+// SetCollection save all NFT and return error if existed, no storage deposit
+// is locked as the deposits are restored by SetDeposit
 func (k Keeper) SetCollection(ctx sdk.Context, collection types.Collection) error {
+	return k.ImportCollection(ctx, collection, nil)
+}
+
+// ImportCollection saves the NFTs like SetCollection, the mint is recorded in
+// the history of the NFTs except the ones of withHistory, whose history is
+// restored by SetTokenHistory
+func (k Keeper) ImportCollection(ctx sdk.Context, collection types.Collection, withHistory map[string]bool) error {
 	for _, nft := range collection.NFTs {
-		if err := k.MintNFT(ctx,
+		if withHistory[nft.GetID()] {
+			if _, err := k.storeNewNFT(ctx, collection.Denom.Id, nft); err != nil {
+				return err
+			}
+			continue
+		}
+		if _, err := k.mintNFT(ctx,
 			collection.Denom.Id,
 			nft.GetID(),
 			nft.GetName(),
 			nft.GetURI(),
+			nft.GetURIHash(),
 			nft.GetData(),
+			nft.GetAttributes(),
 			nft.GetOwner(),
 		); err != nil {
 			return err
@@ -26,6 +47,8 @@ func (k Keeper) SetCollection(ctx sdk.Context, collection types.Collection) erro
 
 // GetCollection returns the collection by the specified denomID
 func (k Keeper) GetCollection(ctx sdk.Context, denomID string) (types.Collection, error) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), "get_collection")
+
 	denom, err := k.GetDenom(ctx, denomID)
 	if err != nil {
 		return types.Collection{}, sdkerrors.Wrapf(types.ErrInvalidDenom, "denomID %s not existed ", denomID)
@@ -35,12 +58,49 @@ func (k Keeper) GetCollection(ctx sdk.Context, denomID string) (types.Collection
 	return types.NewCollection(denom, nfts), nil
 }
 
-// GetCollections returns all the collection
-func (k Keeper) GetCollections(ctx sdk.Context) (cs []types.Collection) {
-	for _, denom := range k.GetDenoms(ctx) {
-		nfts := k.GetNFTs(ctx, denom.Id)
-		cs = append(cs, types.NewCollection(denom, nfts))
+// GetPaginateCollection returns the collection by the specified denomID with
+// a page of its NFTs in the order of their ids
+func (k Keeper) GetPaginateCollection(ctx sdk.Context,
+	denomID string,
+	pagination *query.PageRequest) (types.Collection, *query.PageResponse, error) {
+	denom, err := k.GetDenom(ctx, denomID)
+	if err != nil {
+		return types.Collection{}, nil, sdkerrors.Wrapf(types.ErrInvalidDenom, "denomID %s not existed ", denomID)
 	}
+
+	store := ctx.KVStore(k.storeKey)
+	nftStore := prefix.NewStore(store, types.KeyNFT(denomID, ""))
+
+	collection := types.Collection{Denom: denom}
+	pageRes, err := query.Paginate(nftStore, pagination, func(_ []byte, value []byte) error {
+		var nft types.BaseNFT
+		if err := k.cdc.UnmarshalBinaryBare(value, &nft); err != nil {
+			return err
+		}
+		collection.NFTs = append(collection.NFTs, nft)
+		return nil
+	})
+	if err != nil {
+		return types.Collection{}, nil, err
+	}
+	return collection, pageRes, nil
+}
+
+// GetCollections returns all the collection, each NFT is decoded once straight
+// into its collection
+func (k Keeper) GetCollections(ctx sdk.Context) (cs []types.Collection) {
+	k.IterateDenoms(ctx, func(denom types.Denom) bool {
+		c := types.Collection{
+			Denom: denom,
+			NFTs:  make([]types.BaseNFT, 0, k.GetTotalSupply(ctx, denom.Id)),
+		}
+		k.IterateNFTs(ctx, denom.Id, func(nft types.BaseNFT) bool {
+			c.NFTs = append(c.NFTs, nft)
+			return false
+		})
+		cs = append(cs, c)
+		return false
+	})
 	return cs
 }
 
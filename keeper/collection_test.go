@@ -1,14 +1,20 @@
 package keeper_test
 
 import (
+	"bytes"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irismod/nft"
 	"github.com/irismod/nft/keeper"
 	"github.com/irismod/nft/types"
 )
 
 func (suite *KeeperSuite) TestSetCollection() {
-	nft := types.NewBaseNFT(tokenID, tokenNm, address, tokenURI, tokenData)
+	nft := types.NewBaseNFT(tokenID, tokenNm, address, tokenURI, tokenURIHash, tokenData, tokenAttributes)
 	// create a new NFT and add it to the collection created with the NFT mint
-	nft2 := types.NewBaseNFT(tokenID2, tokenNm, address, tokenURI, tokenData)
+	nft2 := types.NewBaseNFT(tokenID2, tokenNm, address, tokenURI, tokenURIHash, tokenData, tokenAttributes)
 
 	denomE := types.Denom{
 		Id:      denomID,
@@ -33,9 +39,33 @@ func (suite *KeeperSuite) TestSetCollection() {
 	suite.False(fail, msg)
 }
 
+func (suite *KeeperSuite) TestImportCollection() {
+	collection := types.Collection{
+		Denom: types.Denom{Id: denomID},
+		NFTs: []types.BaseNFT{
+			types.NewBaseNFT(tokenID, tokenNm, address, tokenURI, tokenURIHash, tokenData, tokenAttributes),
+			types.NewBaseNFT(tokenID2, tokenNm, address, tokenURI, tokenURIHash, tokenData, tokenAttributes),
+		},
+	}
+	suite.NoError(suite.keeper.ImportCollection(suite.ctx, collection, map[string]bool{tokenID: true}))
+
+	// the NFT whose history is imported isn't recorded as minted
+	entries, _, err := suite.keeper.GetHistory(suite.ctx, denomID, tokenID, nil)
+	suite.NoError(err)
+	suite.Empty(entries)
+
+	entries, _, err = suite.keeper.GetHistory(suite.ctx, denomID, tokenID2, nil)
+	suite.NoError(err)
+	suite.Len(entries, 1)
+	suite.Equal(types.HistoryActionMint, entries[0].Action)
+
+	suite.Equal(uint64(2), suite.keeper.GetTotalSupply(suite.ctx, denomID))
+	suite.Equal(uint64(2), suite.keeper.GetTraitCount(suite.ctx, denomID, "rarity", "legendary"))
+}
+
 func (suite *KeeperSuite) TestGetCollection() {
 	// MintNFT shouldn't fail when collection does not exist
-	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenData, address)
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
 	suite.NoError(err)
 
 	// collection should exist
@@ -50,7 +80,7 @@ func (suite *KeeperSuite) TestGetCollection() {
 func (suite *KeeperSuite) TestGetCollections() {
 
 	// MintNFT shouldn't fail when collection does not exist
-	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenData, address)
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
 	suite.NoError(err)
 
 	msg, fail := keeper.SupplyInvariant(suite.keeper)(suite.ctx)
@@ -59,15 +89,15 @@ func (suite *KeeperSuite) TestGetCollections() {
 
 func (suite *KeeperSuite) TestGetSupply() {
 	// MintNFT shouldn't fail when collection does not exist
-	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenData, address)
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
 	suite.NoError(err)
 
 	// MintNFT shouldn't fail when collection does not exist
-	err = suite.keeper.MintNFT(suite.ctx, denomID, tokenID2, tokenNm2, tokenURI, tokenData, address2)
+	err = suite.keeper.MintNFT(suite.ctx, denomID, tokenID2, tokenNm2, tokenURI, tokenURIHash, tokenData, tokenAttributes, address2, address2)
 	suite.NoError(err)
 
 	// MintNFT shouldn't fail when collection does not exist
-	err = suite.keeper.MintNFT(suite.ctx, denomID2, tokenID, tokenNm2, tokenURI, tokenData, address2)
+	err = suite.keeper.MintNFT(suite.ctx, denomID2, tokenID, tokenNm2, tokenURI, tokenURIHash, tokenData, tokenAttributes, address2, address2)
 	suite.NoError(err)
 
 	supply := suite.keeper.GetTotalSupply(suite.ctx, denomID)
@@ -108,3 +138,51 @@ func (suite *KeeperSuite) TestGetSupply() {
 	supply = suite.keeper.GetTotalSupply(suite.ctx, denomID)
 	suite.Equal(uint64(0), supply)
 }
+
+func (suite *KeeperSuite) TestWriteGenesis() {
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
+	suite.NoError(err)
+	err = suite.keeper.MintNFT(suite.ctx, denomID, tokenID2, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address2)
+	suite.NoError(err)
+	suite.keeper.SetDenomPaused(suite.ctx, denomID2, true)
+	suite.NoError(suite.keeper.SetHidden(suite.ctx, denomID, tokenID2, true))
+	suite.keeper.SetDeposit(suite.ctx, types.TokenDeposit{DenomId: denomID, TokenId: tokenID, Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)})
+	suite.NoError(suite.keeper.GrantAuthorization(suite.ctx, address, address2, types.NewTransferAuthorization(denomID, nil), suite.ctx.BlockTime().Add(time.Hour)))
+
+	// the streamed export matches the encoding of the whole genesis state
+	cdc := suite.app.AppCodec()
+	var buf bytes.Buffer
+	suite.NoError(nft.WriteGenesis(suite.ctx, suite.keeper, cdc, &buf))
+	suite.Equal(string(cdc.MustMarshalJSON(nft.ExportGenesis(suite.ctx, suite.keeper))), buf.String())
+}
+
+func (suite *KeeperSuite) TestInitGenesisLowercasesIDs() {
+	denom := types.NewDenom("DenomMixed", "denommixed", schema, "", "", "", 0, false, address)
+	genesis := nft.DefaultGenesisState()
+	genesis.Collections = []types.Collection{{
+		Denom: denom,
+		NFTs:  []types.BaseNFT{{Id: "TokenMixed", Name: tokenNm, URI: tokenURI, Owner: address}},
+	}}
+	genesis.Hidden = []types.Hidden{{DenomId: "DenomMixed", TokenId: "TokenMixed"}}
+	genesis.PausedDenoms = []string{"DenomMixed"}
+	nft.InitGenesis(suite.ctx, suite.keeper, *genesis)
+
+	suite.False(suite.keeper.HasDenomID(suite.ctx, "DenomMixed"))
+	suite.True(suite.keeper.HasNFT(suite.ctx, "denommixed", "tokenmixed"))
+	suite.True(suite.keeper.IsHidden(suite.ctx, "denommixed", "tokenmixed"))
+	suite.True(suite.keeper.IsDenomPaused(suite.ctx, "denommixed"))
+	suite.Equal("DenomMixed", genesis.Collections[0].Denom.Id)
+}
+
+func (suite *KeeperSuite) TestInitGenesisUnknownPolicy() {
+	genesis := nft.DefaultGenesisState()
+	genesis.Collections = []types.Collection{{Denom: types.NewDenom("kitty", "kitty", schema, "", "", "", 0, false, address)}}
+	genesis.Policies = []types.DenomTransferPolicy{{DenomId: "kitty", Policy: "royalty"}}
+
+	// the policies are checked before anything is written
+	suite.PanicsWithValue(
+		types.ValidateGenesisPolicies(*genesis, suite.keeper.HasTransferPolicy).Error(),
+		func() { nft.InitGenesis(suite.ctx, suite.keeper, *genesis) },
+	)
+	suite.False(suite.keeper.HasDenomID(suite.ctx, "kitty"))
+}
@@ -56,6 +56,16 @@ func (k Keeper) GetDenom(ctx sdk.Context, id string) (denom types.Denom, err err
 
 // GetDenoms return all the denoms
 func (k Keeper) GetDenoms(ctx sdk.Context) (denoms []types.Denom) {
+	k.IterateDenoms(ctx, func(denom types.Denom) bool {
+		denoms = append(denoms, denom)
+		return false
+	})
+	return denoms
+}
+
+// IterateDenoms calls cb on every denom in the order of their ids, until cb
+// returns true
+func (k Keeper) IterateDenoms(ctx sdk.Context, cb func(denom types.Denom) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
 	iterator := sdk.KVStorePrefixIterator(store, types.KeyDenomID(""))
 	defer iterator.Close()
@@ -63,7 +73,42 @@ func (k Keeper) GetDenoms(ctx sdk.Context) (denoms []types.Denom) {
 	for ; iterator.Valid(); iterator.Next() {
 		var denom types.Denom
 		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &denom)
-		denoms = append(denoms, denom)
+		if cb(denom) {
+			break
+		}
+	}
+}
+
+// authorizeDenomCreator checks that the sender is the creator of the denom
+func (k Keeper) authorizeDenomCreator(ctx sdk.Context, denomID string, sender sdk.AccAddress) error {
+	denom, err := k.GetDenom(ctx, denomID)
+	if err != nil {
+		return err
+	}
+	if !sender.Equals(denom.Creator) {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not the creator of %s", sender, denomID)
+	}
+	return nil
+}
+
+// resolveTokenURI returns the nft with its uri resolved against the base uri
+// of the denom, the stored uri is returned when the denom doesn't exist
+func (k Keeper) resolveTokenURI(ctx sdk.Context, denomID string, nft types.BaseNFT) types.BaseNFT {
+	denom, err := k.GetDenom(ctx, denomID)
+	if err != nil {
+		return nft
+	}
+	nft.URI = denom.TokenURI(nft.Id, nft.URI)
+	return nft
+}
+
+// resolveTokenURIs resolves the uris of the nfts of the denom in place
+func (k Keeper) resolveTokenURIs(ctx sdk.Context, denomID string, nfts []types.BaseNFT) {
+	denom, err := k.GetDenom(ctx, denomID)
+	if err != nil {
+		return
+	}
+	for i := range nfts {
+		nfts[i].URI = denom.TokenURI(nfts[i].Id, nfts[i].URI)
 	}
-	return denoms
 }
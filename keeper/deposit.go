@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/irismod/nft/types"
+)
+
+// GetDepositAccount returns the module account holding the storage deposits
+func (k Keeper) GetDepositAccount(ctx sdk.Context) authtypes.ModuleAccountI {
+	return k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+}
+
+// GetDeposit returns the storage deposit locked for the NFT
+func (k Keeper) GetDeposit(ctx sdk.Context, denomID, tokenID string) (deposit sdk.Coin, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyDeposit(denomID, tokenID))
+	if len(bz) == 0 {
+		return deposit, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &deposit)
+	return deposit, true
+}
+
+// GetDeposits returns the storage deposits of every NFT
+func (k Keeper) GetDeposits(ctx sdk.Context) (deposits []types.TokenDeposit) {
+	k.IterateDeposits(ctx, func(deposit types.TokenDeposit) bool {
+		deposits = append(deposits, deposit)
+		return false
+	})
+	return deposits
+}
+
+// IterateDeposits calls cb on the storage deposit of every NFT in the order of
+// their denoms, until cb returns true
+func (k Keeper) IterateDeposits(ctx sdk.Context, cb func(deposit types.TokenDeposit) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyDeposit("", ""))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		denomID, tokenID, err := types.SplitKeyDeposit(iterator.Key())
+		if err != nil {
+			continue
+		}
+
+		var amount sdk.Coin
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &amount)
+		if cb(types.TokenDeposit{
+			DenomId: denomID,
+			TokenId: tokenID,
+			Amount:  amount,
+		}) {
+			break
+		}
+	}
+}
+
+// SetDeposit records the storage deposit of the NFT without moving any coins,
+// the coins must already be held by the module account
+func (k Keeper) SetDeposit(ctx sdk.Context, deposit types.TokenDeposit) {
+	store := ctx.KVStore(k.storeKey)
+	if deposit.Amount.IsZero() {
+		store.Delete(types.KeyDeposit(deposit.DenomId, deposit.TokenId))
+		return
+	}
+	store.Set(types.KeyDeposit(deposit.DenomId, deposit.TokenId), k.cdc.MustMarshalBinaryBare(&deposit.Amount))
+}
+
+// adjustDeposit locks the deposit required by the stored size of the NFT,
+// the account pays the increase and receives the refund of the decrease
+func (k Keeper) adjustDeposit(ctx sdk.Context,
+	denomID, tokenID string,
+	size int,
+	account sdk.AccAddress) error {
+	required := k.GetParams(ctx).Deposit(size)
+
+	locked, found := k.GetDeposit(ctx, denomID, tokenID)
+	if found && locked.Denom != required.Denom {
+		// the deposit denom was changed, refund the whole deposit before locking a new one
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, account, sdk.NewCoins(locked)); err != nil {
+			return err
+		}
+		found = false
+	}
+	if !found {
+		locked = sdk.NewCoin(required.Denom, sdk.ZeroInt())
+	}
+
+	switch {
+	case locked.IsLT(required):
+		diff := sdk.NewCoins(required.Sub(locked))
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, account, types.ModuleName, diff); err != nil {
+			return err
+		}
+	case required.IsLT(locked):
+		diff := sdk.NewCoins(locked.Sub(required))
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, account, diff); err != nil {
+			return err
+		}
+	}
+
+	k.SetDeposit(ctx, types.TokenDeposit{DenomId: denomID, TokenId: tokenID, Amount: required})
+	return nil
+}
+
+// refundDeposit returns the storage deposit of the NFT to the account
+func (k Keeper) refundDeposit(ctx sdk.Context, denomID, tokenID string, account sdk.AccAddress) error {
+	locked, found := k.GetDeposit(ctx, denomID, tokenID)
+	if !found {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, account, sdk.NewCoins(locked)); err != nil {
+		return err
+	}
+	k.SetDeposit(ctx, types.TokenDeposit{DenomId: denomID, TokenId: tokenID, Amount: sdk.NewCoin(locked.Denom, sdk.ZeroInt())})
+	return nil
+}
@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irismod/nft/keeper"
+	"github.com/irismod/nft/types"
+)
+
+func (suite *KeeperSuite) nftSize(denomID, tokenID string) int64 {
+	nft, err := suite.keeper.GetNFT(suite.ctx, denomID, tokenID)
+	suite.NoError(err)
+	baseNFT := nft.(types.BaseNFT)
+	return int64(len(suite.app.AppCodec().MustMarshalBinaryBare(&baseNFT)))
+}
+
+func (suite *KeeperSuite) TestDeposit() {
+	initial := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000))
+	suite.NoError(suite.app.BankKeeper.SetBalances(suite.ctx, address, initial))
+	suite.keeper.SetParams(suite.ctx, types.NewParams(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2), false))
+	moduleAddr := suite.keeper.GetDepositAccount(suite.ctx).GetAddress()
+
+	// minting without funds fails
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID2, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address3, address3)
+	suite.Error(err)
+
+	err = suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address2)
+	suite.NoError(err)
+
+	locked := sdk.NewInt64Coin(sdk.DefaultBondDenom, 2*suite.nftSize(denomID, tokenID))
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, denomID, tokenID)
+	suite.True(found)
+	suite.Equal(locked, deposit)
+	suite.Equal(initial.Sub(sdk.NewCoins(locked)), suite.app.BankKeeper.GetAllBalances(suite.ctx, address))
+	suite.Equal(sdk.NewCoins(locked), suite.app.BankKeeper.GetAllBalances(suite.ctx, moduleAddr))
+
+	response, err := suite.queryClient.Deposit(gocontext.Background(), &types.QueryDepositRequest{
+		Denom: denomID,
+		Id:    tokenID,
+	})
+	suite.NoError(err)
+	suite.Equal(locked, response.Deposit)
+
+	// growing the data locks more coins from the owner
+	suite.NoError(suite.app.BankKeeper.SetBalances(suite.ctx, address2, initial))
+	err = suite.keeper.EditNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, strings.Repeat("a", 100), types.DoNotModifyAttributes(), false, address2)
+	suite.NoError(err)
+
+	grown := sdk.NewInt64Coin(sdk.DefaultBondDenom, 2*suite.nftSize(denomID, tokenID))
+	deposit, _ = suite.keeper.GetDeposit(suite.ctx, denomID, tokenID)
+	suite.Equal(grown, deposit)
+	suite.Equal(initial.Sub(sdk.NewCoins(grown.Sub(locked))), suite.app.BankKeeper.GetAllBalances(suite.ctx, address2))
+
+	// shrinking the data refunds the owner
+	err = suite.keeper.EditNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, types.DoNotModifyAttributes(), false, address2)
+	suite.NoError(err)
+
+	deposit, _ = suite.keeper.GetDeposit(suite.ctx, denomID, tokenID)
+	suite.Equal(locked, deposit)
+	suite.Equal(initial, suite.app.BankKeeper.GetAllBalances(suite.ctx, address2))
+
+	// a plain transfer neither charges nor refunds the sender for a rate change
+	suite.keeper.SetParams(suite.ctx, types.NewParams(sdk.NewInt64Coin(sdk.DefaultBondDenom, 3), false))
+	err = suite.keeper.TransferOwner(suite.ctx, denomID, tokenID, types.DoNotModify, types.DoNotModify, types.DoNotModify, types.DoNotModify, address2, address)
+	suite.NoError(err)
+
+	deposit, _ = suite.keeper.GetDeposit(suite.ctx, denomID, tokenID)
+	suite.Equal(locked, deposit)
+	suite.Equal(initial, suite.app.BankKeeper.GetAllBalances(suite.ctx, address2))
+	suite.Equal(initial.Sub(sdk.NewCoins(locked)), suite.app.BankKeeper.GetAllBalances(suite.ctx, address))
+
+	// the sender changing the data settles the deposit at the current rate
+	err = suite.keeper.TransferOwner(suite.ctx, denomID, tokenID, types.DoNotModify, types.DoNotModify, types.DoNotModify, "b", address, address2)
+	suite.NoError(err)
+
+	required := sdk.NewInt64Coin(sdk.DefaultBondDenom, 3*suite.nftSize(denomID, tokenID))
+	deposit, _ = suite.keeper.GetDeposit(suite.ctx, denomID, tokenID)
+	suite.Equal(required, deposit)
+	suite.Equal(initial.Sub(sdk.NewCoins(required)), suite.app.BankKeeper.GetAllBalances(suite.ctx, address))
+	suite.Equal(initial, suite.app.BankKeeper.GetAllBalances(suite.ctx, address2))
+
+	// burning refunds the whole deposit to the owner
+	err = suite.keeper.BurnNFT(suite.ctx, denomID, tokenID, address2)
+	suite.NoError(err)
+
+	_, found = suite.keeper.GetDeposit(suite.ctx, denomID, tokenID)
+	suite.False(found)
+	suite.Equal(initial.Add(required), suite.app.BankKeeper.GetAllBalances(suite.ctx, address2))
+	suite.True(suite.app.BankKeeper.GetAllBalances(suite.ctx, moduleAddr).IsZero())
+}
+
+func (suite *KeeperSuite) TestDepositInvariant() {
+	suite.keeper.SetParams(suite.ctx, types.NewParams(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false))
+	suite.NoError(suite.app.BankKeeper.SetBalances(suite.ctx, address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000))))
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
+	suite.NoError(err)
+
+	msg, broken := keeper.DepositInvariant(suite.keeper)(suite.ctx)
+	suite.False(broken, msg)
+
+	// coins sent to the module account on top of the deposits are fine
+	moduleAddr := suite.keeper.GetDepositAccount(suite.ctx).GetAddress()
+	suite.NoError(suite.app.BankKeeper.SendCoins(suite.ctx, address, moduleAddr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5))))
+	msg, broken = keeper.DepositInvariant(suite.keeper)(suite.ctx)
+	suite.False(broken, msg)
+
+	// a deposit recorded without its coins breaks the invariant
+	suite.keeper.SetDeposit(suite.ctx, types.TokenDeposit{DenomId: denomID, TokenId: tokenID2, Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)})
+	msg, broken = keeper.DepositInvariant(suite.keeper)(suite.ctx)
+	suite.True(broken, msg)
+}
+
+func (suite *KeeperSuite) TestDepositDisabled() {
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
+	suite.NoError(err)
+
+	_, found := suite.keeper.GetDeposit(suite.ctx, denomID, tokenID)
+	suite.False(found)
+
+	response, err := suite.queryClient.Deposit(gocontext.Background(), &types.QueryDepositRequest{
+		Denom: denomID,
+		Id:    tokenID,
+	})
+	suite.NoError(err)
+	suite.True(response.Deposit.IsZero())
+
+	params, err := suite.queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	suite.NoError(err)
+	suite.Equal(types.DefaultParams(), params.Params)
+}
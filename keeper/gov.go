@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irismod/nft/types"
+)
+
+// ForceBurnNFT deletes the NFT without the authorization of its owner, the
+// storage deposit is still refunded to the owner. The burn is recorded as a
+// governance one in the history of the token.
+func (k Keeper) ForceBurnNFT(ctx sdk.Context, denomID, tokenID string) error {
+	if !k.HasDenomID(ctx, denomID) {
+		return sdkerrors.Wrapf(types.ErrInvalidDenom, "denomID %s not exists", denomID)
+	}
+
+	nft, err := k.GetNFT(ctx, denomID, tokenID)
+	if err != nil {
+		return err
+	}
+	if err := k.burnNFT(ctx, denomID, nft.(types.BaseNFT), types.HistoryActionForceBurn); err != nil {
+		return err
+	}
+
+	k.recordOperation(ctx, denomID, "burn", nil, true)
+	return nil
+}
+
+// SetHidden flags the NFT as hidden, or the whole denom when tokenID is empty
+func (k Keeper) SetHidden(ctx sdk.Context, denomID, tokenID string, hidden bool) error {
+	if !k.HasDenomID(ctx, denomID) {
+		return sdkerrors.Wrapf(types.ErrInvalidDenom, "denomID %s not exists", denomID)
+	}
+	if len(tokenID) > 0 && !k.HasNFT(ctx, denomID, tokenID) {
+		return sdkerrors.Wrapf(types.ErrUnknownNFT, "invalid NFT %s from collection %s", tokenID, denomID)
+	}
+
+	if !hidden {
+		k.deleteHidden(ctx, denomID, tokenID)
+		return nil
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyHidden(denomID, tokenID), []byte{0x01})
+	return nil
+}
+
+// IsHidden returns whether the NFT, or the whole denom when tokenID is empty,
+// is flagged as hidden
+func (k Keeper) IsHidden(ctx sdk.Context, denomID, tokenID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyHidden(denomID, tokenID))
+}
+
+// GetHiddenIDs returns the ids of the hidden NFTs of the denom
+func (k Keeper) GetHiddenIDs(ctx sdk.Context, denomID string) (tokenIDs []string) {
+	k.iterateHiddens(ctx, types.KeyHidden(denomID, ""), func(hidden types.Hidden) bool {
+		if len(hidden.TokenId) > 0 {
+			tokenIDs = append(tokenIDs, hidden.TokenId)
+		}
+		return false
+	})
+	return tokenIDs
+}
+
+// GetHiddens returns the hidden flags of every denom and NFT
+func (k Keeper) GetHiddens(ctx sdk.Context) (hiddens []types.Hidden) {
+	k.IterateHiddens(ctx, func(hidden types.Hidden) bool {
+		hiddens = append(hiddens, hidden)
+		return false
+	})
+	return hiddens
+}
+
+// IterateHiddens calls cb on the hidden flag of every denom and NFT, until cb
+// returns true
+func (k Keeper) IterateHiddens(ctx sdk.Context, cb func(hidden types.Hidden) (stop bool)) {
+	k.iterateHiddens(ctx, types.KeyHidden("", ""), cb)
+}
+
+// ReassignDenomCreator transfers the ownership of the denom to the creator
+func (k Keeper) ReassignDenomCreator(ctx sdk.Context, denomID string, creator sdk.AccAddress) error {
+	denom, err := k.GetDenom(ctx, denomID)
+	if err != nil {
+		return err
+	}
+
+	denom.Creator = creator
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyDenomID(denom.Id), k.cdc.MustMarshalBinaryBare(&denom))
+	return nil
+}
+
+func (k Keeper) iterateHiddens(ctx sdk.Context, prefix []byte, cb func(hidden types.Hidden) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		denomID, tokenID, err := types.SplitKeyHidden(iterator.Key())
+		if err != nil {
+			continue
+		}
+		if cb(types.Hidden{DenomId: denomID, TokenId: tokenID}) {
+			break
+		}
+	}
+}
+
+// getOwnersHidden returns the hidden flags of the denoms and NFTs of the owners
+func (k Keeper) getOwnersHidden(ctx sdk.Context, owners ...types.Owner) (hiddens []types.Hidden) {
+	seen := make(map[string]bool)
+	for _, owner := range owners {
+		for _, idc := range owner.IDCollections {
+			if !seen[idc.Denom] && k.IsHidden(ctx, idc.Denom, "") {
+				hiddens = append(hiddens, types.Hidden{DenomId: idc.Denom})
+			}
+			seen[idc.Denom] = true
+			for _, tokenID := range idc.Ids {
+				if k.IsHidden(ctx, idc.Denom, tokenID) {
+					hiddens = append(hiddens, types.Hidden{DenomId: idc.Denom, TokenId: tokenID})
+				}
+			}
+		}
+	}
+	return hiddens
+}
+
+func (k Keeper) deleteHidden(ctx sdk.Context, denomID, tokenID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyHidden(denomID, tokenID))
+}
@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irismod/nft/types"
+)
+
+func (suite *KeeperSuite) TestForceBurnNFT() {
+	initial := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000))
+	suite.NoError(suite.app.BankKeeper.SetBalances(suite.ctx, address, initial))
+	suite.keeper.SetParams(suite.ctx, types.NewParams(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false))
+
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address2)
+	suite.NoError(err)
+	suite.NoError(suite.keeper.SetHidden(suite.ctx, denomID, tokenID, true))
+	deposit, _ := suite.keeper.GetDeposit(suite.ctx, denomID, tokenID)
+
+	// force burning doesn't require the owner
+	suite.NoError(suite.keeper.ForceBurnNFT(suite.ctx, denomID, tokenID))
+	suite.False(suite.keeper.HasNFT(suite.ctx, denomID, tokenID))
+	suite.False(suite.keeper.IsHidden(suite.ctx, denomID, tokenID))
+	suite.Equal(sdk.NewCoins(deposit), suite.app.BankKeeper.GetAllBalances(suite.ctx, address2))
+
+	entries, _, err := suite.keeper.GetHistory(suite.ctx, denomID, tokenID, nil)
+	suite.NoError(err)
+	suite.Equal(types.HistoryActionForceBurn, entries[len(entries)-1].Action)
+	suite.Equal(address2, entries[len(entries)-1].From)
+
+	suite.Error(suite.keeper.ForceBurnNFT(suite.ctx, denomID, tokenID))
+	suite.Error(suite.keeper.ForceBurnNFT(suite.ctx, "unknown", tokenID))
+}
+
+func (suite *KeeperSuite) TestSetHidden() {
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
+	suite.NoError(err)
+	err = suite.keeper.MintNFT(suite.ctx, denomID, tokenID2, tokenNm2, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
+	suite.NoError(err)
+
+	suite.Error(suite.keeper.SetHidden(suite.ctx, denomID, tokenID3, true))
+	suite.Error(suite.keeper.SetHidden(suite.ctx, "unknown", "", true))
+
+	suite.NoError(suite.keeper.SetHidden(suite.ctx, denomID, tokenID, true))
+
+	nftRes, err := suite.queryClient.NFT(gocontext.Background(), &types.QueryNFTRequest{Denom: denomID, Id: tokenID})
+	suite.NoError(err)
+	suite.True(nftRes.Hidden)
+
+	nftRes, err = suite.queryClient.NFT(gocontext.Background(), &types.QueryNFTRequest{Denom: denomID, Id: tokenID2})
+	suite.NoError(err)
+	suite.False(nftRes.Hidden)
+
+	collectionRes, err := suite.queryClient.Collection(gocontext.Background(), &types.QueryCollectionRequest{Denom: denomID})
+	suite.NoError(err)
+	suite.False(collectionRes.Hidden)
+	suite.Equal([]string{tokenID}, collectionRes.HiddenIDs)
+
+	traitRes, err := suite.queryClient.NFTsByTrait(gocontext.Background(), &types.QueryNFTsByTraitRequest{Denom: denomID, Key: "rarity", Value: "legendary"})
+	suite.NoError(err)
+	suite.False(traitRes.Hidden)
+	suite.Equal([]string{tokenID}, traitRes.HiddenIDs)
+
+	ownerRes, err := suite.queryClient.Owner(gocontext.Background(), &types.QueryOwnerRequest{Owner: address})
+	suite.NoError(err)
+	suite.Equal([]types.Hidden{{DenomId: denomID, TokenId: tokenID}}, ownerRes.Hidden)
+
+	// hiding the denom flags every NFT of the denom
+	suite.NoError(suite.keeper.SetHidden(suite.ctx, denomID, "", true))
+
+	denomRes, err := suite.queryClient.Denom(gocontext.Background(), &types.QueryDenomRequest{Denom: denomID})
+	suite.NoError(err)
+	suite.True(denomRes.Hidden)
+
+	denomsRes, err := suite.queryClient.Denoms(gocontext.Background(), &types.QueryDenomsRequest{})
+	suite.NoError(err)
+	suite.Equal([]string{denomID}, denomsRes.HiddenIDs)
+
+	ownersRes, err := suite.queryClient.Owners(gocontext.Background(), &types.QueryOwnersRequest{Denom: denomID})
+	suite.NoError(err)
+	suite.Equal([]types.Hidden{{DenomId: denomID}, {DenomId: denomID, TokenId: tokenID}}, ownersRes.Hidden)
+
+	traitRes, err = suite.queryClient.NFTsByTrait(gocontext.Background(), &types.QueryNFTsByTraitRequest{Denom: denomID, Key: "rarity", Value: "legendary"})
+	suite.NoError(err)
+	suite.True(traitRes.Hidden)
+
+	nftRes, err = suite.queryClient.NFT(gocontext.Background(), &types.QueryNFTRequest{Denom: denomID, Id: tokenID2})
+	suite.NoError(err)
+	suite.True(nftRes.Hidden)
+
+	suite.Equal([]types.Hidden{{DenomId: denomID}, {DenomId: denomID, TokenId: tokenID}}, suite.keeper.GetHiddens(suite.ctx))
+
+	suite.NoError(suite.keeper.SetHidden(suite.ctx, denomID, "", false))
+	suite.NoError(suite.keeper.SetHidden(suite.ctx, denomID, tokenID, false))
+	suite.Empty(suite.keeper.GetHiddens(suite.ctx))
+}
+
+func (suite *KeeperSuite) TestReassignDenomCreator() {
+	suite.NoError(suite.keeper.ReassignDenomCreator(suite.ctx, denomID, address2))
+
+	denom, err := suite.keeper.GetDenom(suite.ctx, denomID)
+	suite.NoError(err)
+	suite.Equal(address2, denom.Creator)
+	suite.Equal(denomNm, denom.Name)
+
+	suite.Error(suite.keeper.ReassignDenomCreator(suite.ctx, "unknown", address2))
+}
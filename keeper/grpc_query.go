@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/irismod/nft/types"
 )
@@ -32,7 +33,24 @@ func (k Keeper) Owner(c context.Context, request *types.QueryOwnerRequest) (*typ
 	ctx := sdk.UnwrapSDKContext(c)
 	owner := k.GetOwner(ctx, request.Owner, request.Denom)
 	return &types.QueryOwnerResponse{
-		Owner: &owner,
+		Owner:  &owner,
+		Hidden: k.getOwnersHidden(ctx, owner),
+	}, nil
+}
+
+func (k Keeper) Owners(c context.Context, request *types.QueryOwnersRequest) (*types.QueryOwnersResponse, error) {
+	denom := strings.ToLower(strings.TrimSpace(request.Denom))
+	ctx := sdk.UnwrapSDKContext(c)
+
+	owners, pageRes, err := k.GetPaginateOwners(ctx, denom, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryOwnersResponse{
+		Owners:     owners,
+		Pagination: pageRes,
+		Hidden:     k.getOwnersHidden(ctx, owners...),
 	}, nil
 }
 
@@ -40,12 +58,26 @@ func (k Keeper) Collection(c context.Context, request *types.QueryCollectionRequ
 	denom := strings.ToLower(strings.TrimSpace(request.Denom))
 	ctx := sdk.UnwrapSDKContext(c)
 
-	collection, err := k.GetCollection(ctx, denom)
+	var collection types.Collection
+	var pageRes *query.PageResponse
+	var err error
+	if request.Pagination == nil {
+		collection, err = k.GetCollection(ctx, denom)
+	} else {
+		collection, pageRes, err = k.GetPaginateCollection(ctx, denom, request.Pagination)
+	}
 	if err != nil {
 		return nil, err
 	}
+	if !request.RawURIs {
+		k.resolveTokenURIs(ctx, denom, collection.NFTs)
+	}
+
 	return &types.QueryCollectionResponse{
 		Collection: &collection,
+		Hidden:     k.IsHidden(ctx, denom, ""),
+		HiddenIDs:  k.GetHiddenIDs(ctx, denom),
+		Pagination: pageRes,
 	}, nil
 }
 
@@ -59,15 +91,24 @@ func (k Keeper) Denom(c context.Context, request *types.QueryDenomRequest) (*typ
 	}
 
 	return &types.QueryDenomResponse{
-		Denom: &denomObject,
+		Denom:  &denomObject,
+		Hidden: k.IsHidden(ctx, denom, ""),
 	}, nil
 }
 
 func (k Keeper) Denoms(c context.Context, request *types.QueryDenomsRequest) (*types.QueryDenomsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	denoms := k.GetDenoms(ctx)
+
+	var hiddenIDs []string
+	for _, denom := range denoms {
+		if k.IsHidden(ctx, denom.Id, "") {
+			hiddenIDs = append(hiddenIDs, denom.Id)
+		}
+	}
 	return &types.QueryDenomsResponse{
-		Denoms: denoms,
+		Denoms:    denoms,
+		HiddenIDs: hiddenIDs,
 	}, nil
 }
 
@@ -85,8 +126,168 @@ func (k Keeper) NFT(c context.Context, request *types.QueryNFTRequest) (*types.Q
 	if !ok {
 		return nil, sdkerrors.Wrapf(types.ErrUnknownNFT, "invalid type NFT %s from collection %s", request.Id, request.Denom)
 	}
+	if !request.RawURI {
+		baseNFT = k.resolveTokenURI(ctx, denom, baseNFT)
+	}
 
 	return &types.QueryNFTResponse{
-		NFT: &baseNFT,
+		NFT:    &baseNFT,
+		Hidden: k.IsHidden(ctx, denom, "") || k.IsHidden(ctx, denom, tokenID),
+	}, nil
+}
+
+func (k Keeper) History(c context.Context, request *types.QueryHistoryRequest) (*types.QueryHistoryResponse, error) {
+	denom := strings.ToLower(strings.TrimSpace(request.Denom))
+	tokenID := strings.ToLower(strings.TrimSpace(request.Id))
+	ctx := sdk.UnwrapSDKContext(c)
+
+	entries, pageRes, err := k.GetHistory(ctx, denom, tokenID, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryHistoryResponse{
+		Entries:    entries,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) NFTsByTrait(c context.Context, request *types.QueryNFTsByTraitRequest) (*types.QueryNFTsByTraitResponse, error) {
+	denom := strings.ToLower(strings.TrimSpace(request.Denom))
+	ctx := sdk.UnwrapSDKContext(c)
+
+	nfts, pageRes, err := k.GetNFTsByTrait(ctx, denom, strings.TrimSpace(request.Key), strings.TrimSpace(request.Value), request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	k.resolveTokenURIs(ctx, denom, nfts)
+
+	var hiddenIDs []string
+	for _, nft := range nfts {
+		if k.IsHidden(ctx, denom, nft.Id) {
+			hiddenIDs = append(hiddenIDs, nft.Id)
+		}
+	}
+	return &types.QueryNFTsByTraitResponse{
+		NFTs:       nfts,
+		Pagination: pageRes,
+		Hidden:     k.IsHidden(ctx, denom, ""),
+		HiddenIDs:  hiddenIDs,
+	}, nil
+}
+
+func (k Keeper) TraitHistogram(c context.Context, request *types.QueryTraitHistogramRequest) (*types.QueryTraitHistogramResponse, error) {
+	denom := strings.ToLower(strings.TrimSpace(request.Denom))
+	ctx := sdk.UnwrapSDKContext(c)
+
+	traits, pageRes, err := k.GetTraitHistogram(ctx, denom, strings.TrimSpace(request.Key), request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTraitHistogramResponse{
+		Traits:     traits,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) Deposit(c context.Context, request *types.QueryDepositRequest) (*types.QueryDepositResponse, error) {
+	denom := strings.ToLower(strings.TrimSpace(request.Denom))
+	tokenID := strings.ToLower(strings.TrimSpace(request.Id))
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.HasNFT(ctx, denom, tokenID) {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownNFT, "invalid NFT %s from collection %s", request.Id, request.Denom)
+	}
+
+	deposit, found := k.GetDeposit(ctx, denom, tokenID)
+	if !found {
+		deposit = sdk.NewCoin(k.GetParams(ctx).DepositPerByte.Denom, sdk.ZeroInt())
+	}
+	return &types.QueryDepositResponse{Deposit: deposit}, nil
+}
+
+func (k Keeper) Paused(c context.Context, request *types.QueryPausedRequest) (*types.QueryPausedResponse, error) {
+	denom := strings.ToLower(strings.TrimSpace(request.Denom))
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.HasDenomID(ctx, denom) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidDenom, "denomID %s not exists", denom)
+	}
+
+	denomPaused, modulePaused := k.IsDenomPaused(ctx, denom), k.IsModulePaused(ctx)
+	return &types.QueryPausedResponse{
+		Paused:       denomPaused || modulePaused,
+		DenomPaused:  denomPaused,
+		ModulePaused: modulePaused,
 	}, nil
 }
+
+func (k Keeper) TransferPolicy(c context.Context, request *types.QueryTransferPolicyRequest) (*types.QueryTransferPolicyResponse, error) {
+	denom := strings.ToLower(strings.TrimSpace(request.Denom))
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.HasDenomID(ctx, denom) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidDenom, "denomID %s not exists", denom)
+	}
+
+	return &types.QueryTransferPolicyResponse{
+		Policy:    k.GetTransferPolicy(ctx, denom),
+		AllowList: k.GetPolicyList(ctx, denom, types.ListAllow),
+		DenyList:  k.GetPolicyList(ctx, denom, types.ListDeny),
+	}, nil
+}
+
+func (k Keeper) Metadata(c context.Context, request *types.QueryMetadataRequest) (*types.QueryMetadataResponse, error) {
+	denomID := strings.ToLower(strings.TrimSpace(request.Denom))
+	tokenID := strings.ToLower(strings.TrimSpace(request.Id))
+	ctx := sdk.UnwrapSDKContext(c)
+
+	denom, err := k.GetDenom(ctx, denomID)
+	if err != nil {
+		return nil, err
+	}
+
+	nft, err := k.GetNFT(ctx, denomID, tokenID)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownNFT, "invalid NFT %s from collection %s", request.Id, request.Denom)
+	}
+
+	baseNFT, ok := nft.(types.BaseNFT)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownNFT, "invalid type NFT %s from collection %s", request.Id, request.Denom)
+	}
+
+	metadata := types.RenderMetadata(denom, baseNFT)
+	return &metadata, nil
+}
+
+func (k Keeper) Grants(c context.Context, request *types.QueryGrantsRequest) (*types.QueryGrantsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	granter, err := sdk.AccAddressFromBech32(request.Granter)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid granter address (%s)", err)
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(request.Grantee)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address (%s)", err)
+	}
+
+	msgTypeURL := strings.TrimSpace(request.MsgTypeURL)
+	if len(msgTypeURL) == 0 {
+		return &types.QueryGrantsResponse{Grants: k.GetGrants(ctx, granter, grantee)}, nil
+	}
+
+	grant, found := k.GetGrant(ctx, granter, grantee, msgTypeURL)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidGrant, "no authorization of %s granted to %s by %s", msgTypeURL, grantee, granter)
+	}
+	return &types.QueryGrantsResponse{Grants: []types.Grant{grant}}, nil
+}
+
+func (k Keeper) Params(c context.Context, request *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
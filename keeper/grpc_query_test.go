@@ -3,11 +3,14 @@ package keeper_test
 import (
 	gocontext "context"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
 	"github.com/irismod/nft/types"
 )
 
 func (suite *KeeperSuite) TestSupply() {
-	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenData, address)
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
 	suite.NoError(err)
 
 	response, err := suite.queryClient.Supply(gocontext.Background(), &types.QuerySupplyRequest{
@@ -20,7 +23,7 @@ func (suite *KeeperSuite) TestSupply() {
 }
 
 func (suite *KeeperSuite) TestOwner() {
-	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenData, address)
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
 	suite.NoError(err)
 
 	response, err := suite.queryClient.Owner(gocontext.Background(), &types.QueryOwnerRequest{
@@ -33,8 +36,59 @@ func (suite *KeeperSuite) TestOwner() {
 	suite.Contains(response.Owner.IDCollections[0].Ids, tokenID)
 }
 
+func (suite *KeeperSuite) TestOwners() {
+	mints := []struct {
+		denom, id string
+		owner     sdk.AccAddress
+	}{
+		{denomID, tokenID, address},
+		{denomID, tokenID2, address},
+		{denomID, tokenID3, address2},
+		{denomID2, tokenID, address2},
+		{denomID2, tokenID2, address3},
+	}
+	for _, mint := range mints {
+		err := suite.keeper.MintNFT(suite.ctx, mint.denom, mint.id, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, mint.owner, mint.owner)
+		suite.NoError(err)
+	}
+
+	// walking the pages gives the holders of GetOwner
+	holders := make(map[string][]string)
+	var nextKey []byte
+	for {
+		response, err := suite.queryClient.Owners(gocontext.Background(), &types.QueryOwnersRequest{
+			Denom:      denomID,
+			Pagination: &query.PageRequest{Key: nextKey, Limit: 1},
+		})
+		suite.NoError(err)
+		suite.LessOrEqual(len(response.Owners), 1)
+		for _, owner := range response.Owners {
+			suite.Len(owner.IDCollections, 1)
+			suite.Equal(denomID, owner.IDCollections[0].Denom)
+			holders[owner.Address.String()] = append(holders[owner.Address.String()], owner.IDCollections[0].Ids...)
+		}
+
+		nextKey = response.Pagination.NextKey
+		if len(nextKey) == 0 {
+			break
+		}
+	}
+	suite.Len(holders, 2)
+	for _, owner := range []sdk.AccAddress{address, address2} {
+		suite.Equal(suite.keeper.GetOwner(suite.ctx, owner, denomID).IDCollections[0].Ids, holders[owner.String()])
+	}
+
+	// every denom is returned without one
+	response, err := suite.queryClient.Owners(gocontext.Background(), &types.QueryOwnersRequest{})
+	suite.NoError(err)
+	suite.Len(response.Owners, 3)
+	for _, owner := range response.Owners {
+		suite.Equal(suite.keeper.GetOwner(suite.ctx, owner.Address, ""), owner)
+	}
+}
+
 func (suite *KeeperSuite) TestCollection() {
-	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenData, address)
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
 	suite.NoError(err)
 
 	response, err := suite.queryClient.Collection(gocontext.Background(), &types.QueryCollectionRequest{
@@ -45,10 +99,39 @@ func (suite *KeeperSuite) TestCollection() {
 	suite.NotNil(response.Collection)
 	suite.Len(response.Collection.NFTs, 1)
 	suite.Equal(response.Collection.NFTs[0].Id, tokenID)
+	suite.Nil(response.Pagination)
+}
+
+func (suite *KeeperSuite) TestCollectionPagination() {
+	for _, id := range []string{tokenID, tokenID2, tokenID3} {
+		err := suite.keeper.MintNFT(suite.ctx, denomID, id, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
+		suite.NoError(err)
+	}
+
+	var ids []string
+	var nextKey []byte
+	for {
+		response, err := suite.queryClient.Collection(gocontext.Background(), &types.QueryCollectionRequest{
+			Denom:      denomID,
+			Pagination: &query.PageRequest{Key: nextKey, Limit: 2},
+		})
+		suite.NoError(err)
+		suite.Equal(denomID, response.Collection.Denom.Id)
+		suite.LessOrEqual(len(response.Collection.NFTs), 2)
+		for _, nft := range response.Collection.NFTs {
+			ids = append(ids, nft.Id)
+		}
+
+		nextKey = response.Pagination.NextKey
+		if len(nextKey) == 0 {
+			break
+		}
+	}
+	suite.ElementsMatch([]string{tokenID, tokenID2, tokenID3}, ids)
 }
 
 func (suite *KeeperSuite) TestDenom() {
-	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenData, address)
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
 	suite.NoError(err)
 
 	response, err := suite.queryClient.Denom(gocontext.Background(), &types.QueryDenomRequest{
@@ -61,7 +144,7 @@ func (suite *KeeperSuite) TestDenom() {
 }
 
 func (suite *KeeperSuite) TestDenoms() {
-	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenData, address)
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
 	suite.NoError(err)
 
 	response, err := suite.queryClient.Denoms(gocontext.Background(), &types.QueryDenomsRequest{})
@@ -72,7 +155,7 @@ func (suite *KeeperSuite) TestDenoms() {
 }
 
 func (suite *KeeperSuite) TestNFT() {
-	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenData, address)
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
 	suite.NoError(err)
 
 	response, err := suite.queryClient.NFT(gocontext.Background(), &types.QueryNFTRequest{
@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/irismod/nft/types"
+)
+
+// GetHistory returns a page of the retained history entries of the token, oldest first
+func (k Keeper) GetHistory(ctx sdk.Context,
+	denomID, tokenID string,
+	pagination *query.PageRequest) ([]types.HistoryEntry, *query.PageResponse, error) {
+	store := ctx.KVStore(k.storeKey)
+	historyStore := prefix.NewStore(store, types.KeyHistory(denomID, tokenID))
+
+	var entries []types.HistoryEntry
+	pageRes, err := query.Paginate(historyStore, pagination, func(_ []byte, value []byte) error {
+		var entry types.HistoryEntry
+		if err := k.cdc.UnmarshalBinaryBare(value, &entry); err != nil {
+			return err
+		}
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return entries, pageRes, nil
+}
+
+// GetTokenHistories returns the retained history of every token
+func (k Keeper) GetTokenHistories(ctx sdk.Context) (histories []types.TokenHistory) {
+	k.IterateTokenHistories(ctx, func(history types.TokenHistory) bool {
+		histories = append(histories, history)
+		return false
+	})
+	return histories
+}
+
+// IterateTokenHistories calls cb on the retained history of every token in the
+// order of their denoms, until cb returns true. The entries of a token being
+// consecutive, only the history of one token is held at a time.
+func (k Keeper) IterateTokenHistories(ctx sdk.Context, cb func(history types.TokenHistory) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyHistory("", ""))
+	defer iterator.Close()
+
+	var history types.TokenHistory
+	for ; iterator.Valid(); iterator.Next() {
+		denomID, tokenID, err := types.SplitKeyHistoryEntry(iterator.Key())
+		if err != nil {
+			continue
+		}
+
+		var entry types.HistoryEntry
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &entry)
+
+		if history.DenomId != denomID || history.TokenId != tokenID {
+			if len(history.Entries) > 0 && cb(history) {
+				return
+			}
+			history = types.TokenHistory{DenomId: denomID, TokenId: tokenID}
+		}
+		history.Entries = append(history.Entries, entry)
+	}
+	if len(history.Entries) > 0 {
+		cb(history)
+	}
+}
+
+// SetTokenHistory replaces the history of the token by the given entries
+func (k Keeper) SetTokenHistory(ctx sdk.Context, history types.TokenHistory) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyHistory(history.DenomId, history.TokenId))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	nextSequence := k.getHistorySequence(ctx, history.DenomId, history.TokenId)
+	for _, entry := range history.Entries {
+		entry := entry
+		store.Set(types.KeyHistoryEntry(history.DenomId, history.TokenId, entry.Sequence), k.cdc.MustMarshalBinaryBare(&entry))
+		if entry.Sequence >= nextSequence {
+			nextSequence = entry.Sequence + 1
+		}
+	}
+	k.setHistorySequence(ctx, history.DenomId, history.TokenId, nextSequence)
+}
+
+// appendHistory records an ownership change of the token and prunes the
+// entries exceeding the history retention of the denom
+func (k Keeper) appendHistory(ctx sdk.Context,
+	denomID, tokenID string,
+	action types.HistoryAction,
+	from, to sdk.AccAddress) {
+	retention := types.DefaultHistoryRetention
+	if denom, err := k.GetDenom(ctx, denomID); err == nil {
+		retention = denom.GetHistoryRetention()
+	}
+
+	sequence := k.getHistorySequence(ctx, denomID, tokenID)
+	entry := types.HistoryEntry{
+		Sequence: sequence,
+		Action:   action,
+		From:     from,
+		To:       to,
+		Height:   ctx.BlockHeight(),
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyHistoryEntry(denomID, tokenID, sequence), k.cdc.MustMarshalBinaryBare(&entry))
+	if sequence >= retention {
+		store.Delete(types.KeyHistoryEntry(denomID, tokenID, sequence-retention))
+	}
+	k.setHistorySequence(ctx, denomID, tokenID, sequence+1)
+}
+
+func (k Keeper) getHistorySequence(ctx sdk.Context, denomID, tokenID string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyHistorySequence(denomID, tokenID))
+	if len(bz) == 0 {
+		return 0
+	}
+	return types.MustUnMarshalSupply(k.cdc, bz)
+}
+
+func (k Keeper) setHistorySequence(ctx sdk.Context, denomID, tokenID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalSupply(k.cdc, sequence)
+	store.Set(types.KeyHistorySequence(denomID, tokenID), bz)
+}
@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/irismod/nft/types"
+)
+
+func (suite *KeeperSuite) TestHistory() {
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
+	suite.NoError(err)
+
+	err = suite.keeper.TransferOwner(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, address, address2)
+	suite.NoError(err)
+
+	err = suite.keeper.BurnNFT(suite.ctx, denomID, tokenID, address2)
+	suite.NoError(err)
+
+	response, err := suite.queryClient.History(gocontext.Background(), &types.QueryHistoryRequest{
+		Denom: denomID,
+		Id:    tokenID,
+	})
+	suite.NoError(err)
+	suite.Len(response.Entries, 3)
+
+	suite.Equal(types.HistoryActionMint, response.Entries[0].Action)
+	suite.True(response.Entries[0].From.Empty())
+	suite.Equal(address, response.Entries[0].To)
+
+	suite.Equal(types.HistoryActionTransfer, response.Entries[1].Action)
+	suite.Equal(address, response.Entries[1].From)
+	suite.Equal(address2, response.Entries[1].To)
+	suite.Equal(suite.ctx.BlockHeight(), response.Entries[1].Height)
+
+	suite.Equal(types.HistoryActionBurn, response.Entries[2].Action)
+	suite.Equal(address2, response.Entries[2].From)
+	suite.True(response.Entries[2].To.Empty())
+
+	// paginated
+	response, err = suite.queryClient.History(gocontext.Background(), &types.QueryHistoryRequest{
+		Denom:      denomID,
+		Id:         tokenID,
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1},
+	})
+	suite.NoError(err)
+	suite.Len(response.Entries, 1)
+	suite.Equal(uint64(1), response.Entries[0].Sequence)
+}
+
+func (suite *KeeperSuite) TestHistoryRetention() {
+	// denomID2 only keeps the last 2 entries of each token
+	err := suite.keeper.MintNFT(suite.ctx, denomID2, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
+	suite.NoError(err)
+
+	err = suite.keeper.TransferOwner(suite.ctx, denomID2, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, address, address2)
+	suite.NoError(err)
+
+	err = suite.keeper.TransferOwner(suite.ctx, denomID2, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, address2, address3)
+	suite.NoError(err)
+
+	entries, _, err := suite.keeper.GetHistory(suite.ctx, denomID2, tokenID, nil)
+	suite.NoError(err)
+	suite.Len(entries, 2)
+	suite.Equal(uint64(1), entries[0].Sequence)
+	suite.Equal(address3, entries[1].To)
+}
+
+func (suite *KeeperSuite) TestTokenHistories() {
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
+	suite.NoError(err)
+
+	err = suite.keeper.MintNFT(suite.ctx, denomID2, tokenID2, tokenNm2, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
+	suite.NoError(err)
+
+	err = suite.keeper.TransferOwner(suite.ctx, denomID2, tokenID2, tokenNm2, tokenURI, tokenURIHash, tokenData, address, address2)
+	suite.NoError(err)
+
+	histories := suite.keeper.GetTokenHistories(suite.ctx)
+	suite.Len(histories, 2)
+	suite.Equal(denomID, histories[0].DenomId)
+	suite.Equal(tokenID, histories[0].TokenId)
+	suite.Len(histories[0].Entries, 1)
+	suite.Equal(denomID2, histories[1].DenomId)
+	suite.Len(histories[1].Entries, 2)
+
+	// importing a history replaces the recorded one and continues its sequence
+	history := histories[1]
+	history.Entries = history.Entries[1:]
+	suite.keeper.SetTokenHistory(suite.ctx, history)
+
+	err = suite.keeper.TransferOwner(suite.ctx, denomID2, tokenID2, tokenNm2, tokenURI, tokenURIHash, tokenData, address2, address3)
+	suite.NoError(err)
+
+	entries, _, err := suite.keeper.GetHistory(suite.ctx, denomID2, tokenID2, nil)
+	suite.NoError(err)
+	suite.Len(entries, 2)
+	suite.Equal(uint64(1), entries[0].Sequence)
+	suite.Equal(uint64(2), entries[1].Sequence)
+}
@@ -4,6 +4,7 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -16,12 +17,19 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		types.ModuleName, "supply",
 		SupplyInvariant(k),
 	)
+	ir.RegisterRoute(
+		types.ModuleName, "deposits",
+		DepositInvariant(k),
+	)
 }
 
 // AllInvariants runs all invariants of the nfts module.
 func AllInvariants(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		return SupplyInvariant(k)(ctx)
+		if msg, broken := SupplyInvariant(k)(ctx); broken {
+			return msg, broken
+		}
+		return DepositInvariant(k)(ctx)
 	}
 }
 
@@ -32,19 +40,32 @@ func SupplyInvariant(k Keeper) sdk.Invariant {
 		var msg string
 		count := 0
 
-		for _, owner := range k.GetOwners(ctx) {
-			for _, idCollection := range owner.IDCollections {
-				ownersCollectionsSupply[idCollection.Denom] += uint64(idCollection.Supply())
-			}
-		}
+		k.IterateOwners(ctx, func(_ sdk.AccAddress, denomID, _ string) bool {
+			ownersCollectionsSupply[denomID]++
+			return false
+		})
 
-		for denom, supply := range ownersCollectionsSupply {
-			if supply != k.GetTotalSupply(ctx, denom) {
+		k.IterateDenoms(ctx, func(denom types.Denom) bool {
+			supply := k.GetTotalSupply(ctx, denom.Id)
+			if supply != ownersCollectionsSupply[denom.Id] {
 				count++
 				msg += fmt.Sprintf("total %s NFTs supply invariance:\n"+
 					"\ttotal %s NFTs supply: %d\n"+
-					"\tsum of %s NFTs by owner: %d\n", denom, denom, supply, denom, ownersCollectionsSupply[denom])
+					"\tsum of %s NFTs by owner: %d\n", denom.Id, denom.Id, supply, denom.Id, ownersCollectionsSupply[denom.Id])
 			}
+			delete(ownersCollectionsSupply, denom.Id)
+			return false
+		})
+
+		// owner entries left over reference a denom which doesn't exist
+		unknown := make([]string, 0, len(ownersCollectionsSupply))
+		for denomID := range ownersCollectionsSupply {
+			unknown = append(unknown, denomID)
+		}
+		sort.Strings(unknown)
+		for _, denomID := range unknown {
+			count++
+			msg += fmt.Sprintf("unknown denom %s owned by addresses: %d NFTs\n", denomID, ownersCollectionsSupply[denomID])
 		}
 		broken := count != 0
 
@@ -52,3 +73,22 @@ func SupplyInvariant(k Keeper) sdk.Invariant {
 			"%d NFT supply invariants found\n%s", count, msg)), broken
 	}
 }
+
+// DepositInvariant checks that the module account holds at least the sum of the storage deposits,
+// anyone may send more coins to the module account
+func DepositInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		deposits := sdk.NewCoins()
+		k.IterateDeposits(ctx, func(deposit types.TokenDeposit) bool {
+			deposits = deposits.Add(deposit.Amount)
+			return false
+		})
+
+		balance := k.bankKeeper.GetAllBalances(ctx, k.GetDepositAccount(ctx).GetAddress())
+		broken := !balance.IsAllGTE(deposits)
+
+		return sdk.FormatInvariant(types.ModuleName, "deposits", fmt.Sprintf(
+			"\tsum of the NFT deposits: %s\n"+
+				"\tbalance of the module account: %s\n", deposits, balance)), broken
+	}
+}
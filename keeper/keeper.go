@@ -8,21 +8,58 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/irismod/nft/types"
 )
 
 // Keeper maintains the link to data storage and exposes getter/setter methods for the various parts of the state machine
 type Keeper struct {
-	storeKey sdk.StoreKey // Unexposed key to access store from sdk.Context
-	cdc      codec.Marshaler
+	storeKey      sdk.StoreKey // Unexposed key to access store from sdk.Context
+	cdc           codec.Marshaler
+	paramSpace    paramtypes.Subspace
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+
+	// metricDenoms is the allow-list of the denoms labelled in the metrics
+	metricDenoms map[string]bool
+
+	// policies are the transfer policies selectable by the denoms, by name
+	policies map[string]types.TransferPolicy
 }
 
-// NewKeeper creates new instances of the nft Keeper
-func NewKeeper(cdc codec.Marshaler, storeKey sdk.StoreKey) Keeper {
+// NewKeeper creates new instances of the nft Keeper, the custom transfer
+// policies are registered next to the built-in allow-list and deny-list ones
+func NewKeeper(cdc codec.Marshaler,
+	storeKey sdk.StoreKey,
+	paramSpace paramtypes.Subspace,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	policies ...types.TransferPolicy) Keeper {
+	// ensure the module account holding the storage deposits is set
+	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	registered := make(map[string]types.TransferPolicy)
+	for _, policy := range append([]types.TransferPolicy{types.AllowListPolicy{}, types.DenyListPolicy{}}, policies...) {
+		if _, ok := registered[policy.Name()]; ok || len(policy.Name()) == 0 {
+			panic(fmt.Sprintf("transfer policy %q is already registered or has no name", policy.Name()))
+		}
+		registered[policy.Name()] = policy
+	}
+
 	return Keeper{
-		storeKey: storeKey,
-		cdc:      cdc,
+		storeKey:      storeKey,
+		cdc:           cdc,
+		paramSpace:    paramSpace,
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+		policies:      registered,
 	}
 }
 
@@ -32,94 +69,168 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 }
 
 func (k Keeper) IssueDenom(ctx sdk.Context,
-	id, name, schema string,
+	id, name, schema, uri, uriHash, baseURI string,
+	historyRetention uint64,
+	revocable bool,
 	creator sdk.AccAddress) error {
-	return k.SetDenom(ctx, types.NewDenom(id, name, schema, creator))
+	return k.SetDenom(ctx, types.NewDenom(id, name, schema, uri, uriHash, baseURI, historyRetention, revocable, creator))
 }
 
-// MintNFT mints an NFT and manages that NFTs existence within Collections and Owners
+// MintNFT mints an NFT and manages that NFTs existence within Collections and Owners,
+// the sender pays the storage deposit of the NFT
 func (k Keeper) MintNFT(ctx sdk.Context,
-	denomID, tokenID, tokenNm, tokenURI, tokenData string,
-	owner sdk.AccAddress) error {
-	if !k.HasDenomID(ctx, denomID) {
-		return sdkerrors.Wrapf(types.ErrInvalidDenom, "denomID %s not exists", denomID)
+	denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData string,
+	attributes []types.Attribute,
+	sender, owner sdk.AccAddress) error {
+	if err := k.assertNotPaused(ctx, denomID); err != nil {
+		return err
+	}
+	if err := k.checkTransferPolicy(ctx, denomID, tokenID, sender, owner); err != nil {
+		return err
 	}
 
-	if k.HasNFT(ctx, denomID, tokenID) {
-		return sdkerrors.Wrapf(types.ErrNFTAlreadyExists, "NFT %s already exists in collection %s", tokenID, denomID)
+	size, err := k.mintNFT(ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, attributes, owner)
+	if err != nil {
+		return err
 	}
+	return k.adjustDeposit(ctx, denomID, tokenID, size, sender)
+}
 
-	k.setNFT(ctx, denomID, types.NewBaseNFT(
+// mintNFT stores a new NFT without locking its storage deposit and returns
+// the size of the stored NFT
+func (k Keeper) mintNFT(ctx sdk.Context,
+	denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData string,
+	attributes []types.Attribute,
+	owner sdk.AccAddress) (int, error) {
+	size, err := k.storeNewNFT(ctx, denomID, types.NewBaseNFT(
 		tokenID,
 		tokenNm,
 		owner,
 		tokenURI,
+		tokenURIHash,
 		tokenData,
+		attributes,
 	))
-	k.setOwner(ctx, denomID, tokenID, owner)
+	if err != nil {
+		return 0, err
+	}
+
+	k.appendHistory(ctx, denomID, tokenID, types.HistoryActionMint, nil, owner)
+	return size, nil
+}
+
+// storeNewNFT stores a new NFT with its traits, owner and supply, without
+// recording it in its history, and returns the size of the stored NFT
+func (k Keeper) storeNewNFT(ctx sdk.Context, denomID string, nft types.BaseNFT) (int, error) {
+	if !k.HasDenomID(ctx, denomID) {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidDenom, "denomID %s not exists", denomID)
+	}
+
+	if k.HasNFT(ctx, denomID, nft.GetID()) {
+		return 0, sdkerrors.Wrapf(types.ErrNFTAlreadyExists, "NFT %s already exists in collection %s", nft.GetID(), denomID)
+	}
+
+	size := k.setNFT(ctx, denomID, nft)
+	k.setTraits(ctx, denomID, nft)
+	k.setOwner(ctx, denomID, nft.GetID(), nft.GetOwner())
 	k.increaseSupply(ctx, denomID)
-	return nil
+	return size, nil
 }
 
-// EditNFT updates an already existing NFTs
+// EditNFT updates an already existing NFTs, the attributes are kept when empty
+// or types.DoNotModifyAttributes and removed when clearAttributes is set
 func (k Keeper) EditNFT(ctx sdk.Context,
-	denomID, tokenID, tokenNm, tokenURI, tokenData string,
+	denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData string,
+	attributes []types.Attribute,
+	clearAttributes bool,
 	owner sdk.AccAddress) error {
 	if !k.HasDenomID(ctx, denomID) {
 		return sdkerrors.Wrapf(types.ErrInvalidDenom, "denomID %s not exists", denomID)
 	}
+	if err := k.assertNotPaused(ctx, denomID); err != nil {
+		return err
+	}
 
 	nft, err := k.Authorize(ctx, denomID, tokenID, owner)
 	if err != nil {
 		return err
 	}
 
-	if tokenNm != types.DoNotModify {
-		nft.Name = tokenNm
-	}
+	modified := modifyNFT(&nft, tokenNm, tokenURI, tokenURIHash, tokenData)
 
-	if tokenURI != types.DoNotModify {
-		nft.URI = tokenURI
+	if clearAttributes || !types.IsDoNotModifyAttributes(attributes) {
+		if clearAttributes {
+			attributes = nil
+		}
+		k.deleteTraits(ctx, denomID, nft)
+		nft.Attributes = attributes
+		k.setTraits(ctx, denomID, nft)
+		modified = true
 	}
 
-	if tokenData != types.DoNotModify {
-		nft.Data = tokenData
+	size := k.setNFT(ctx, denomID, nft)
+	if modified {
+		if err := k.adjustDeposit(ctx, denomID, tokenID, size, owner); err != nil {
+			return err
+		}
 	}
-
-	k.setNFT(ctx, denomID, nft)
 	return nil
 }
 
 // TransferOwner gets all the ID Collections owned by an address
 func (k Keeper) TransferOwner(ctx sdk.Context,
-	denomID, tokenID, tokenNm, tokenURI, tokenData string,
+	denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData string,
 	srcOwner, dstOwner sdk.AccAddress) error {
 	if !k.HasDenomID(ctx, denomID) {
 		return sdkerrors.Wrapf(types.ErrInvalidDenom, "denomID %s not exists", denomID)
 	}
+	if err := k.assertNotPaused(ctx, denomID); err != nil {
+		return err
+	}
 
 	nft, err := k.Authorize(ctx, denomID, tokenID, srcOwner)
 	if err != nil {
 		return err
 	}
+	if err := k.checkTransferPolicy(ctx, denomID, tokenID, srcOwner, dstOwner); err != nil {
+		return err
+	}
 
 	nft.Owner = dstOwner
+	modified := modifyNFT(&nft, tokenNm, tokenURI, tokenURIHash, tokenData)
 
-	if tokenNm != types.DoNotModify {
-		nft.Name = tokenNm
-	}
-
-	if tokenURI != types.DoNotModify {
-		nft.URI = tokenURI
+	size := k.setNFT(ctx, denomID, nft)
+	k.swapOwner(ctx, denomID, tokenID, srcOwner, dstOwner)
+	k.appendHistory(ctx, denomID, tokenID, types.HistoryActionTransfer, srcOwner, dstOwner)
+	// the sender only settles the deposit of the fields it changed, a plain
+	// transfer leaves the deposit as it was locked
+	if modified {
+		if err := k.adjustDeposit(ctx, denomID, tokenID, size, srcOwner); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	if tokenData != types.DoNotModify {
-		nft.Data = tokenData
+// modifyNFT sets the fields of the NFT that are not types.DoNotModify and
+// returns whether any of them changed
+func modifyNFT(nft *types.BaseNFT, tokenNm, tokenURI, tokenURIHash, tokenData string) bool {
+	modified := false
+	for _, field := range []struct {
+		value string
+		dst   *string
+	}{
+		{tokenNm, &nft.Name},
+		{tokenURI, &nft.URI},
+		{tokenURIHash, &nft.URIHash},
+		{tokenData, &nft.Data},
+	} {
+		if field.value != types.DoNotModify && field.value != *field.dst {
+			*field.dst = field.value
+			modified = true
+		}
 	}
-
-	k.setNFT(ctx, denomID, nft)
-	k.swapOwner(ctx, denomID, tokenID, srcOwner, dstOwner)
-	return nil
+	return modified
 }
 
 // BurnNFT delete a specified nft
@@ -129,14 +240,26 @@ func (k Keeper) BurnNFT(ctx sdk.Context,
 	if !k.HasDenomID(ctx, denomID) {
 		return sdkerrors.Wrapf(types.ErrInvalidDenom, "denomID %s not exists", denomID)
 	}
+	if err := k.assertNotPaused(ctx, denomID); err != nil {
+		return err
+	}
 
 	nft, err := k.Authorize(ctx, denomID, tokenID, owner)
 	if err != nil {
 		return err
 	}
+	return k.burnNFT(ctx, denomID, nft, types.HistoryActionBurn)
+}
+
+// burnNFT deletes the NFT and refunds its storage deposit to its owner
+func (k Keeper) burnNFT(ctx sdk.Context, denomID string, nft types.BaseNFT, action types.HistoryAction) error {
+	tokenID, owner := nft.GetID(), nft.GetOwner()
 
+	k.deleteTraits(ctx, denomID, nft)
 	k.deleteNFT(ctx, denomID, nft)
 	k.deleteOwner(ctx, denomID, tokenID, owner)
 	k.decreaseSupply(ctx, denomID)
-	return nil
+	k.appendHistory(ctx, denomID, tokenID, action, owner, nil)
+	k.deleteHidden(ctx, denomID, tokenID)
+	return k.refundDeposit(ctx, denomID, tokenID, owner)
 }
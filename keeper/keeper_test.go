@@ -23,9 +23,14 @@ var (
 	denomNm = "denomnm"
 	schema  = "{a:a,b:b}"
 
+	denomURI     = "https://google.com/denom.json"
+	denomURIHash = "1220a591a6d40bf420404a011733cfb7b190d62c65bf0bcda32b57b277d9ad9f146e"
+
 	denomID2 = "denomid2"
 	denomNm2 = "denom2nm"
 
+	historyRetention2 uint64 = 2
+
 	tokenID  = "tokenid"
 	tokenID2 = "tokenid2"
 	tokenID3 = "tokenid3"
@@ -39,7 +44,14 @@ var (
 	address3  = CreateTestAddrs(3)[2]
 	tokenURI  = "https://google.com/token-1.json"
 	tokenURI2 = "https://google.com/token-2.json"
-	tokenData = "{a:a,b:b}"
+
+	tokenURIHash = "a591a6d40bf420404a011733cfb7b190d62c65bf0bcda32b57b277d9ad9f146e"
+	tokenData    = "{a:a,b:b}"
+
+	tokenAttributes = []types.Attribute{
+		types.NewAttribute("rarity", "legendary", types.AttributeTypeString),
+		types.NewAttribute("level", "3", types.AttributeTypeNumber),
+	}
 
 	isCheckTx = false
 )
@@ -53,6 +65,7 @@ type KeeperSuite struct {
 	app         *simapp.SimApp
 
 	queryClient types.QueryClient
+	msgClient   types.MsgClient
 }
 
 func (suite *KeeperSuite) SetupTest() {
@@ -66,13 +79,15 @@ func (suite *KeeperSuite) SetupTest() {
 
 	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, app.InterfaceRegistry())
 	types.RegisterQueryServer(queryHelper, app.NFTKeeper)
+	types.RegisterMsgServer(queryHelper, keeper.NewMsgServerImpl(app.NFTKeeper))
 	suite.queryClient = types.NewQueryClient(queryHelper)
+	suite.msgClient = types.NewMsgClient(queryHelper)
 
-	err := suite.keeper.IssueDenom(suite.ctx, denomID, denomNm, schema, address)
+	err := suite.keeper.IssueDenom(suite.ctx, denomID, denomNm, schema, denomURI, denomURIHash, "", 0, false, address)
 	suite.NoError(err)
 
 	// MintNFT shouldn't fail when collection does not exist
-	err = suite.keeper.IssueDenom(suite.ctx, denomID2, denomNm2, schema, address)
+	err = suite.keeper.IssueDenom(suite.ctx, denomID2, denomNm2, schema, denomURI, denomURIHash, "", historyRetention2, false, address)
 	suite.NoError(err)
 
 	// collections should equal 1
@@ -87,53 +102,63 @@ func TestKeeperSuite(t *testing.T) {
 
 func (suite *KeeperSuite) TestMintNFT() {
 	// MintNFT shouldn't fail when collection does not exist
-	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenData, address)
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
 	suite.NoError(err)
 
 	// MintNFT shouldn't fail when collection exists
-	err = suite.keeper.MintNFT(suite.ctx, denomID, tokenID2, tokenNm2, tokenURI, tokenData, address)
+	err = suite.keeper.MintNFT(suite.ctx, denomID, tokenID2, tokenNm2, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
 	suite.NoError(err)
 }
 
 func (suite *KeeperSuite) TestUpdateNFT() {
 	// EditNFT should fail when NFT doesn't exists
-	err := suite.keeper.EditNFT(suite.ctx, denomID, tokenID, tokenNm3, tokenURI, tokenData, address)
+	err := suite.keeper.EditNFT(suite.ctx, denomID, tokenID, tokenNm3, tokenURI, tokenURIHash, tokenData, tokenAttributes, false, address)
 	suite.Error(err)
 
 	// MintNFT shouldn't fail when collection does not exist
-	err = suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenData, address)
+	err = suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
 	suite.NoError(err)
 
 	// EditNFT should fail when NFT doesn't exists
-	err = suite.keeper.EditNFT(suite.ctx, denomID, tokenID2, tokenNm2, tokenURI, tokenData, address)
+	err = suite.keeper.EditNFT(suite.ctx, denomID, tokenID2, tokenNm2, tokenURI, tokenURIHash, tokenData, tokenAttributes, false, address)
 	suite.Error(err)
 
 	// EditNFT shouldn't fail when NFT exists
-	err = suite.keeper.EditNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI2, tokenData, address)
+	err = suite.keeper.EditNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI2, tokenURIHash, tokenData, tokenAttributes, false, address)
 	suite.NoError(err)
 
 	// GetNFT should get the NFT with new tokenURI
 	receivedNFT, err := suite.keeper.GetNFT(suite.ctx, denomID, tokenID)
 	suite.NoError(err)
 	suite.Equal(receivedNFT.GetURI(), tokenURI2)
+	suite.Equal(receivedNFT.GetURIHash(), tokenURIHash)
+
+	// EditNFT should keep the uriHash when it is not modified
+	err = suite.keeper.EditNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, types.DoNotModify, tokenData, tokenAttributes, false, address)
+	suite.NoError(err)
+
+	receivedNFT, err = suite.keeper.GetNFT(suite.ctx, denomID, tokenID)
+	suite.NoError(err)
+	suite.Equal(receivedNFT.GetURI(), tokenURI)
+	suite.Equal(receivedNFT.GetURIHash(), tokenURIHash)
 
 	// EditNFT shouldn't fail when NFT exists
-	err = suite.keeper.EditNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI2, tokenData, address2)
+	err = suite.keeper.EditNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI2, tokenURIHash, tokenData, tokenAttributes, false, address2)
 	suite.Error(err)
 }
 
 func (suite *KeeperSuite) TestTransferOwner() {
 
 	// MintNFT shouldn't fail when collection does not exist
-	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenData, address)
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
 	suite.NoError(err)
 
 	//invalid owner
-	err = suite.keeper.TransferOwner(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenData, address2, address3)
+	err = suite.keeper.TransferOwner(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, address2, address3)
 	suite.Error(err)
 
 	//right
-	err = suite.keeper.TransferOwner(suite.ctx, denomID, tokenID, tokenNm2, tokenURI2, tokenData, address, address2)
+	err = suite.keeper.TransferOwner(suite.ctx, denomID, tokenID, tokenNm2, tokenURI2, tokenURIHash, tokenData, address, address2)
 	suite.NoError(err)
 
 	nft, err := suite.keeper.GetNFT(suite.ctx, denomID, tokenID)
@@ -143,7 +168,7 @@ func (suite *KeeperSuite) TestTransferOwner() {
 
 func (suite *KeeperSuite) TestBurnNFT() {
 	// MintNFT should not fail when collection does not exist
-	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenData, address)
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
 	suite.NoError(err)
 
 	// BurnNFT should fail when NFT doesn't exist but collection does exist
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irismod/nft/types"
+)
+
+// SetMetadataTemplate sets the metadata template of the denom, only the
+// creator of the denom is allowed to do so. A nil template restores the
+// default one.
+func (k Keeper) SetMetadataTemplate(ctx sdk.Context, denomID string, template *types.MetadataTemplate, sender sdk.AccAddress) error {
+	if err := k.authorizeDenomCreator(ctx, denomID, sender); err != nil {
+		return err
+	}
+	if err := types.ValidateMetadataTemplate(template); err != nil {
+		return err
+	}
+
+	denom, err := k.GetDenom(ctx, denomID)
+	if err != nil {
+		return err
+	}
+
+	denom.MetadataTemplate = template
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyDenomID(denom.Id), k.cdc.MustMarshalBinaryBare(&denom))
+	return nil
+}
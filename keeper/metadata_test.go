@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	"github.com/irismod/nft/types"
+)
+
+func (suite *KeeperSuite) TestMetadata() {
+	data := `{"description":"a cat","image":"ipfs://tom.png","traits":{"color":"grey"}}`
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, data, nil, address, address)
+	suite.NoError(err)
+
+	// the default template reads the fields at the root of the data
+	response, err := suite.queryClient.Metadata(gocontext.Background(), &types.QueryMetadataRequest{
+		Denom: denomID,
+		Id:    tokenID,
+	})
+	suite.NoError(err)
+	suite.Equal(tokenNm, response.Name)
+	suite.Equal("a cat", response.Description)
+	suite.Equal("ipfs://tom.png", response.Image)
+	suite.Empty(response.Attributes)
+
+	// only the creator can set the template
+	template := &types.MetadataTemplate{Image: "/missing", Attributes: "/traits"}
+	_, err = suite.msgClient.SetMetadataTemplate(gocontext.Background(), types.NewMsgSetMetadataTemplate(denomID, template, address2.String()))
+	suite.True(types.ErrUnauthorized.Is(err))
+	_, err = suite.msgClient.SetMetadataTemplate(gocontext.Background(), types.NewMsgSetMetadataTemplate(denomID, &types.MetadataTemplate{Image: "image"}, address.String()))
+	suite.True(types.ErrInvalidMetadataTemplate.Is(err))
+	_, err = suite.msgClient.SetMetadataTemplate(gocontext.Background(), types.NewMsgSetMetadataTemplate(denomID, template, address.String()))
+	suite.NoError(err)
+
+	denom, err := suite.keeper.GetDenom(suite.ctx, denomID)
+	suite.NoError(err)
+	suite.Equal(template, denom.MetadataTemplate)
+
+	response, err = suite.queryClient.Metadata(gocontext.Background(), &types.QueryMetadataRequest{
+		Denom: denomID,
+		Id:    tokenID,
+	})
+	suite.NoError(err)
+	suite.Empty(response.Description)
+	suite.Equal(tokenURI, response.Image)
+	suite.Equal([]types.MetadataAttribute{{TraitType: "color", Value: "grey"}}, response.Attributes)
+
+	// a nil template restores the default one
+	_, err = suite.msgClient.SetMetadataTemplate(gocontext.Background(), types.NewMsgSetMetadataTemplate(denomID, nil, address.String()))
+	suite.NoError(err)
+	response, err = suite.queryClient.Metadata(gocontext.Background(), &types.QueryMetadataRequest{
+		Denom: denomID,
+		Id:    tokenID,
+	})
+	suite.NoError(err)
+	suite.Equal("a cat", response.Description)
+
+	_, err = suite.queryClient.Metadata(gocontext.Background(), &types.QueryMetadataRequest{
+		Denom: denomID,
+		Id:    tokenID2,
+	})
+	suite.True(types.ErrUnknownNFT.Is(err))
+}
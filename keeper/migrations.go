@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/irismod/nft/migrations/v2"
+	"github.com/irismod/nft/types"
+)
+
+// Migrator is a struct for handling in-place store migrations
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2, the param subspace of the module
+// is new in v2 and gets the default params
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := v2.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc); err != nil {
+		return err
+	}
+	m.keeper.SetParams(ctx, types.DefaultParams())
+	return nil
+}
+
+// GetConsensusVersion returns the consensus version of the store, a store
+// without version predates the versioning and is at version 1
+func (k Keeper) GetConsensusVersion(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyConsensusVersion())
+	if len(bz) == 0 {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetConsensusVersion sets the consensus version of the store
+func (k Keeper) SetConsensusVersion(ctx sdk.Context, version uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyConsensusVersion(), sdk.Uint64ToBigEndian(version))
+}
@@ -0,0 +1,673 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irismod/nft/exported"
+	"github.com/irismod/nft/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the NFT MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// IssueDenom issues a new denom
+func (m msgServer) IssueDenom(goCtx context.Context, msg *types.MsgIssueDenom) (*types.MsgIssueDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	id := strings.ToLower(strings.TrimSpace(msg.Id))
+	name := strings.ToLower(strings.TrimSpace(msg.Name))
+
+	if err := m.Keeper.IssueDenom(ctx,
+		id,
+		name,
+		msg.Schema,
+		msg.URI,
+		msg.URIHash,
+		msg.BaseURI,
+		msg.HistoryRetention,
+		msg.Revocable,
+		sender); err != nil {
+		return nil, err
+	}
+	if !ctx.IsCheckTx() {
+		telemetry.IncrCounter(1, types.ModuleName, "issue_denom")
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeIssueDenom,
+			sdk.NewAttribute(types.AttributeKeyDenom, id),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	if err := types.EmitTypedEvent(ctx, &types.EventIssueDenom{
+		DenomId:   id,
+		DenomName: name,
+		Creator:   msg.Sender,
+	}); err != nil {
+		return nil, err
+	}
+	return &types.MsgIssueDenomResponse{DenomId: id}, nil
+}
+
+// MintNFT mints a new NFT and returns its denom and token id
+func (m msgServer) MintNFT(goCtx context.Context, msg *types.MsgMintNFT) (*types.MsgMintNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	id := strings.ToLower(strings.TrimSpace(msg.Id))
+	denom := strings.ToLower(strings.TrimSpace(msg.Denom))
+
+	if err := m.Keeper.MintNFT(ctx,
+		denom,
+		id,
+		strings.TrimSpace(msg.Name),
+		strings.TrimSpace(msg.URI),
+		strings.TrimSpace(msg.URIHash),
+		msg.Data,
+		msg.Attributes,
+		sender,
+		recipient); err != nil {
+		return nil, err
+	}
+
+	nft, _ := m.Keeper.GetNFT(ctx, denom, id)
+	m.Keeper.recordOperation(ctx, denom, "mint", nft, true)
+
+	tokenURI := m.Keeper.resolveTokenURI(ctx, denom, nft.(types.BaseNFT)).URI
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeMintNFT,
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyTokenID, id),
+			sdk.NewAttribute(types.AttributeKeyTokenURI, tokenURI),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	if err := types.EmitTypedEvent(ctx, &types.EventMint{
+		DenomId:   denom,
+		TokenId:   id,
+		TokenURI:  tokenURI,
+		Sender:    msg.Sender,
+		Recipient: msg.Recipient,
+	}); err != nil {
+		return nil, err
+	}
+	return &types.MsgMintNFTResponse{DenomId: denom, TokenId: id}, nil
+}
+
+// EditNFT edits the fields of a NFT
+func (m msgServer) EditNFT(goCtx context.Context, msg *types.MsgEditNFT) (*types.MsgEditNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	id := strings.ToLower(strings.TrimSpace(msg.Id))
+	denom := strings.ToLower(strings.TrimSpace(msg.Denom))
+
+	before, _ := m.Keeper.GetNFT(ctx, denom, id)
+	if err := m.Keeper.EditNFT(ctx,
+		denom,
+		id,
+		strings.TrimSpace(msg.Name),
+		strings.TrimSpace(msg.URI),
+		strings.TrimSpace(msg.URIHash),
+		msg.Data,
+		msg.Attributes,
+		msg.ClearAttributes,
+		sender); err != nil {
+		return nil, err
+	}
+
+	after, _ := m.Keeper.GetNFT(ctx, denom, id)
+	m.Keeper.recordOperation(ctx, denom, "edit", after, false)
+
+	tokenURI := msg.URI
+	if tokenURI != types.DoNotModify {
+		tokenURI = m.Keeper.resolveTokenURI(ctx, denom, after.(types.BaseNFT)).URI
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeEditNFT,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyTokenID, id),
+			sdk.NewAttribute(types.AttributeKeyTokenURI, tokenURI),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	if err := types.EmitTypedEvent(ctx, &types.EventEdit{
+		DenomId: denom,
+		TokenId: id,
+		Sender:  msg.Sender,
+		Changes: m.nftChanges(ctx, denom, before, after),
+	}); err != nil {
+		return nil, err
+	}
+	return &types.MsgEditNFTResponse{}, nil
+}
+
+// TransferNFT transfers the ownership of a NFT
+func (m msgServer) TransferNFT(goCtx context.Context, msg *types.MsgTransferNFT) (*types.MsgTransferNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	id := strings.ToLower(strings.TrimSpace(msg.Id))
+	denom := strings.ToLower(strings.TrimSpace(msg.Denom))
+
+	before, _ := m.Keeper.GetNFT(ctx, denom, id)
+	if err := m.Keeper.TransferOwner(ctx,
+		denom,
+		id,
+		strings.TrimSpace(msg.Name),
+		strings.TrimSpace(msg.URI),
+		strings.TrimSpace(msg.URIHash),
+		msg.Data,
+		sender,
+		recipient); err != nil {
+		return nil, err
+	}
+
+	after, _ := m.Keeper.GetNFT(ctx, denom, id)
+	m.Keeper.recordOperation(ctx, denom, "transfer", after, false)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransfer,
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyTokenID, id),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	if err := types.EmitTypedEvent(ctx, &types.EventTransfer{
+		DenomId:   denom,
+		TokenId:   id,
+		Sender:    msg.Sender,
+		Recipient: msg.Recipient,
+		Changes:   m.nftChanges(ctx, denom, before, after),
+	}); err != nil {
+		return nil, err
+	}
+	return &types.MsgTransferNFTResponse{}, nil
+}
+
+// BurnNFT burns a NFT
+func (m msgServer) BurnNFT(goCtx context.Context, msg *types.MsgBurnNFT) (*types.MsgBurnNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	id := strings.ToLower(strings.TrimSpace(msg.Id))
+	denom := strings.ToLower(strings.TrimSpace(msg.Denom))
+
+	nft, _ := m.Keeper.GetNFT(ctx, denom, id)
+	if err := m.Keeper.BurnNFT(ctx,
+		denom,
+		id,
+		sender,
+	); err != nil {
+		return nil, err
+	}
+	m.Keeper.recordOperation(ctx, denom, "burn", nil, true)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeBurnNFT,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyTokenID, id),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	if err := types.EmitTypedEvent(ctx, &types.EventBurn{
+		DenomId: denom,
+		TokenId: id,
+		Owner:   nft.GetOwner().String(),
+	}); err != nil {
+		return nil, err
+	}
+	return &types.MsgBurnNFTResponse{}, nil
+}
+
+// PauseDenom pauses the NFTs of a denom
+func (m msgServer) PauseDenom(goCtx context.Context, msg *types.MsgPauseDenom) (*types.MsgPauseDenomResponse, error) {
+	if err := m.setDenomPaused(goCtx, msg.Denom, true, msg.Sender, types.EventTypePause); err != nil {
+		return nil, err
+	}
+	return &types.MsgPauseDenomResponse{}, nil
+}
+
+// UnpauseDenom unpauses the NFTs of a denom
+func (m msgServer) UnpauseDenom(goCtx context.Context, msg *types.MsgUnpauseDenom) (*types.MsgUnpauseDenomResponse, error) {
+	if err := m.setDenomPaused(goCtx, msg.Denom, false, msg.Sender, types.EventTypeUnpause); err != nil {
+		return nil, err
+	}
+	return &types.MsgUnpauseDenomResponse{}, nil
+}
+
+func (m msgServer) setDenomPaused(goCtx context.Context, denom string, paused bool, signer, eventType string) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(signer)
+	if err != nil {
+		return err
+	}
+
+	denom = strings.ToLower(strings.TrimSpace(denom))
+	if err := m.Keeper.PauseDenom(ctx, denom, paused, sender); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, signer),
+		),
+	})
+
+	return types.EmitTypedEvent(ctx, &types.EventPauseDenom{
+		DenomId: denom,
+		Sender:  signer,
+		Paused:  paused,
+	})
+}
+
+// SetTransferPolicy sets the transfer policy of a denom
+func (m msgServer) SetTransferPolicy(goCtx context.Context, msg *types.MsgSetTransferPolicy) (*types.MsgSetTransferPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	denom := strings.ToLower(strings.TrimSpace(msg.Denom))
+	policy := strings.TrimSpace(msg.Policy)
+	if err := m.Keeper.SetTransferPolicy(ctx, denom, policy, sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetTransferPolicy,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyPolicy, policy),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+	return &types.MsgSetTransferPolicyResponse{}, nil
+}
+
+// UpdatePolicyList adds and removes addresses of a policy list of a denom
+func (m msgServer) UpdatePolicyList(goCtx context.Context, msg *types.MsgUpdatePolicyList) (*types.MsgUpdatePolicyListResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	add, err := parseAddresses(msg.Add)
+	if err != nil {
+		return nil, err
+	}
+
+	remove, err := parseAddresses(msg.Remove)
+	if err != nil {
+		return nil, err
+	}
+
+	denom := strings.ToLower(strings.TrimSpace(msg.Denom))
+	list := strings.ToLower(strings.TrimSpace(msg.List))
+	if err := m.Keeper.UpdatePolicyList(ctx, denom, list, add, remove, sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdatePolicyList,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyList, list),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+	return &types.MsgUpdatePolicyListResponse{}, nil
+}
+
+// SetMetadataTemplate sets the metadata template of a denom
+func (m msgServer) SetMetadataTemplate(goCtx context.Context, msg *types.MsgSetMetadataTemplate) (*types.MsgSetMetadataTemplateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	denom := strings.ToLower(strings.TrimSpace(msg.Denom))
+	if err := m.Keeper.SetMetadataTemplate(ctx, denom, msg.Template, sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetMetadataTemplate,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+	return &types.MsgSetMetadataTemplateResponse{}, nil
+}
+
+// nftChanges returns the changed fields of the nft, the uris are resolved
+// against the base uri of the denom
+func (m msgServer) nftChanges(ctx sdk.Context, denom string, before, after exported.NFT) []types.FieldChange {
+	return types.NFTChanges(
+		m.Keeper.resolveTokenURI(ctx, denom, before.(types.BaseNFT)),
+		m.Keeper.resolveTokenURI(ctx, denom, after.(types.BaseNFT)),
+	)
+}
+
+func parseAddresses(bech32s []string) ([]sdk.AccAddress, error) {
+	addresses := make([]sdk.AccAddress, len(bech32s))
+	for i, bech32 := range bech32s {
+		address, err := sdk.AccAddressFromBech32(bech32)
+		if err != nil {
+			return nil, err
+		}
+		addresses[i] = address
+	}
+	return addresses, nil
+}
+
+// RevokeNFT burns or reclaims a NFT of a revocable denom
+func (m msgServer) RevokeNFT(goCtx context.Context, msg *types.MsgRevokeNFT) (*types.MsgRevokeNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	var recipient sdk.AccAddress
+	if len(msg.Recipient) > 0 {
+		if recipient, err = sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+			return nil, err
+		}
+	}
+
+	id := strings.ToLower(strings.TrimSpace(msg.Id))
+	denom := strings.ToLower(strings.TrimSpace(msg.Denom))
+
+	nft, _ := m.Keeper.GetNFT(ctx, denom, id)
+	if err := m.Keeper.RevokeNFT(ctx,
+		denom,
+		id,
+		sender,
+		recipient); err != nil {
+		return nil, err
+	}
+
+	// a reclaimed NFT is stored again, a burnt one changes the supply
+	if recipient.Empty() {
+		m.Keeper.recordOperation(ctx, denom, "revoke", nil, true)
+	} else {
+		reclaimed, _ := m.Keeper.GetNFT(ctx, denom, id)
+		m.Keeper.recordOperation(ctx, denom, "revoke", reclaimed, false)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevokeNFT,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyTokenID, id),
+			sdk.NewAttribute(types.AttributeKeyOwner, nft.GetOwner().String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	if err := types.EmitTypedEvent(ctx, &types.EventRevoke{
+		DenomId:   denom,
+		TokenId:   id,
+		Owner:     nft.GetOwner().String(),
+		Sender:    msg.Sender,
+		Recipient: msg.Recipient,
+	}); err != nil {
+		return nil, err
+	}
+	return &types.MsgRevokeNFTResponse{}, nil
+}
+
+// GrantAuthorization grants an authorization to execute nft messages on behalf
+// of the granter
+func (m msgServer) GrantAuthorization(goCtx context.Context, msg *types.MsgGrantAuthorization) (*types.MsgGrantAuthorizationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	authorization := msg.GetAuthorization()
+	if authorization == nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidGrant, "missing authorization")
+	}
+	if err := m.Keeper.GrantAuthorization(ctx, granter, grantee, authorization, msg.Expiration); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeGrantAuthorization,
+			sdk.NewAttribute(types.AttributeKeyGranter, msg.Granter),
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee),
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, authorization.MsgTypeURL()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Granter),
+		),
+	})
+	return &types.MsgGrantAuthorizationResponse{}, nil
+}
+
+// RevokeAuthorization revokes a granted authorization
+func (m msgServer) RevokeAuthorization(goCtx context.Context, msg *types.MsgRevokeAuthorization) (*types.MsgRevokeAuthorizationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.RevokeAuthorization(ctx, granter, grantee, msg.MsgTypeURL); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevokeAuthorization,
+			sdk.NewAttribute(types.AttributeKeyGranter, msg.Granter),
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee),
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msg.MsgTypeURL),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Granter),
+		),
+	})
+	return &types.MsgRevokeAuthorizationResponse{}, nil
+}
+
+// ExecAuthorized executes the messages on behalf of their signer, the grantee
+// must hold an authorization of the signer accepting each message unless it
+// signed it itself
+func (m msgServer) ExecAuthorized(goCtx context.Context, msg *types.MsgExecAuthorized) (*types.MsgExecAuthorizedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([][]byte, len(msgs))
+	for i, authorized := range msgs {
+		signers := authorized.GetSigners()
+		if len(signers) != 1 {
+			return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "message %d must have a single signer", i)
+		}
+
+		granter := signers[0]
+		if !granter.Equals(grantee) {
+			if err := m.Keeper.acceptGrant(ctx, granter, grantee, authorized); err != nil {
+				return nil, err
+			}
+		}
+
+		if results[i], err = m.execAuthorized(goCtx, authorized); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Grantee),
+		),
+	})
+	return &types.MsgExecAuthorizedResponse{Results: results}, nil
+}
+
+// execAuthorized executes a message having an authorization and returns the
+// encoding of its response
+func (m msgServer) execAuthorized(goCtx context.Context, msg sdk.Msg) ([]byte, error) {
+	var (
+		res proto.Message
+		err error
+	)
+	switch msg := msg.(type) {
+	case *types.MsgTransferNFT:
+		res, err = m.TransferNFT(goCtx, msg)
+	case *types.MsgMintNFT:
+		res, err = m.MintNFT(goCtx, msg)
+	case *types.MsgEditNFT:
+		res, err = m.EditNFT(goCtx, msg)
+	case *types.MsgBurnNFT:
+		res, err = m.BurnNFT(goCtx, msg)
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "message %T can't be executed with an authorization", msg)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(res)
+}
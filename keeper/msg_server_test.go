@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	"github.com/irismod/nft/types"
+)
+
+func (suite *KeeperSuite) TestMsgServerIssueDenom() {
+	res, err := suite.msgClient.IssueDenom(gocontext.Background(),
+		types.NewMsgIssueDenom("DenomID3", "denom3nm", schema, denomURI, denomURIHash, "", 0, false, address.String()))
+	suite.NoError(err)
+	suite.Equal("denomid3", res.DenomId)
+
+	denom, err := suite.keeper.GetDenom(suite.ctx, "denomid3")
+	suite.NoError(err)
+	suite.Equal(address, denom.Creator)
+
+	_, err = suite.msgClient.IssueDenom(gocontext.Background(),
+		types.NewMsgIssueDenom(denomID, denomNm, schema, denomURI, denomURIHash, "", 0, false, address.String()))
+	suite.Error(err)
+}
+
+func (suite *KeeperSuite) TestMsgServerNFTLifecycle() {
+	mintRes, err := suite.msgClient.MintNFT(gocontext.Background(),
+		types.NewMsgMintNFT(" TokenID ", denomID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address.String(), address.String()))
+	suite.NoError(err)
+	suite.Equal(denomID, mintRes.DenomId)
+	suite.Equal(tokenID, mintRes.TokenId)
+
+	_, err = suite.msgClient.EditNFT(gocontext.Background(),
+		types.NewMsgEditNFT(tokenID, denomID, tokenNm2, tokenURI2, tokenURIHash, tokenData, types.DoNotModifyAttributes(), address.String()))
+	suite.NoError(err)
+
+	_, err = suite.msgClient.TransferNFT(gocontext.Background(),
+		types.NewMsgTransferNFT(tokenID, denomID, types.DoNotModify, types.DoNotModify, types.DoNotModify, types.DoNotModify, address.String(), address2.String()))
+	suite.NoError(err)
+
+	nft, err := suite.keeper.GetNFT(suite.ctx, denomID, tokenID)
+	suite.NoError(err)
+	suite.Equal(address2, nft.GetOwner())
+	suite.Equal(tokenURI2, nft.GetURI())
+
+	_, err = suite.msgClient.BurnNFT(gocontext.Background(), types.NewMsgBurnNFT(address.String(), tokenID, denomID))
+	suite.Error(err)
+
+	_, err = suite.msgClient.BurnNFT(gocontext.Background(), types.NewMsgBurnNFT(address2.String(), tokenID, denomID))
+	suite.NoError(err)
+	suite.False(suite.keeper.HasNFT(suite.ctx, denomID, tokenID))
+}
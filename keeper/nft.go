@@ -24,16 +24,27 @@ func (k Keeper) GetNFT(ctx sdk.Context, denomID, tokenID string) (nft exported.N
 
 // GetNFTs return the all NFT by the specified denomID
 func (k Keeper) GetNFTs(ctx sdk.Context, denom string) (nfts []exported.NFT) {
+	k.IterateNFTs(ctx, denom, func(nft types.BaseNFT) bool {
+		nfts = append(nfts, nft)
+		return false
+	})
+	return nfts
+}
+
+// IterateNFTs calls cb on every NFT of the denom in the order of their ids,
+// until cb returns true
+func (k Keeper) IterateNFTs(ctx sdk.Context, denomID string, cb func(nft types.BaseNFT) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
 
-	iterator := sdk.KVStorePrefixIterator(store, types.KeyNFT(denom, ""))
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyNFT(denomID, ""))
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
 		var baseNFT types.BaseNFT
 		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &baseNFT)
-		nfts = append(nfts, baseNFT)
+		if cb(baseNFT) {
+			break
+		}
 	}
-	return nfts
 }
 
 //Authorize check if the sender is the issuer of nft, if it returns nft, if not, return an error
@@ -57,11 +68,13 @@ func (k Keeper) HasNFT(ctx sdk.Context, denomID, tokenID string) bool {
 	return store.Has(types.KeyNFT(denomID, tokenID))
 }
 
-func (k Keeper) setNFT(ctx sdk.Context, denomID string, nft types.BaseNFT) {
+// setNFT stores the NFT and returns its marshalled size
+func (k Keeper) setNFT(ctx sdk.Context, denomID string, nft types.BaseNFT) int {
 	store := ctx.KVStore(k.storeKey)
 
 	bz := k.cdc.MustMarshalBinaryBare(&nft)
 	store.Set(types.KeyNFT(denomID, nft.GetID()), bz)
+	return len(bz)
 }
 
 // deleteNFT deletes an existing NFT from store
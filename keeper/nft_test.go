@@ -2,11 +2,12 @@ package keeper_test
 
 import (
 	"github.com/irismod/nft/keeper"
+	"github.com/irismod/nft/types"
 )
 
 func (suite *KeeperSuite) TestGetNFT() {
 	// MintNFT shouldn't fail when collection does not exist
-	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenData, address)
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
 	suite.NoError(err)
 
 	// GetNFT should get the NFT
@@ -17,7 +18,7 @@ func (suite *KeeperSuite) TestGetNFT() {
 	suite.Equal(receivedNFT.GetURI(), tokenURI)
 
 	// MintNFT shouldn't fail when collection exists
-	err = suite.keeper.MintNFT(suite.ctx, denomID, tokenID2, tokenNm2, tokenURI, tokenData, address)
+	err = suite.keeper.MintNFT(suite.ctx, denomID, tokenID2, tokenNm2, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
 	suite.NoError(err)
 
 	// GetNFT should get the NFT when collection exists
@@ -32,16 +33,16 @@ func (suite *KeeperSuite) TestGetNFT() {
 }
 
 func (suite *KeeperSuite) TestGetNFTs() {
-	err := suite.keeper.MintNFT(suite.ctx, denomID2, tokenID, tokenNm, tokenURI, tokenData, address)
+	err := suite.keeper.MintNFT(suite.ctx, denomID2, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
 	suite.NoError(err)
 
-	err = suite.keeper.MintNFT(suite.ctx, denomID2, tokenID2, tokenNm2, tokenURI, tokenData, address)
+	err = suite.keeper.MintNFT(suite.ctx, denomID2, tokenID2, tokenNm2, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
 	suite.NoError(err)
 
-	err = suite.keeper.MintNFT(suite.ctx, denomID2, tokenID3, tokenNm3, tokenURI, tokenData, address)
+	err = suite.keeper.MintNFT(suite.ctx, denomID2, tokenID3, tokenNm3, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
 	suite.NoError(err)
 
-	err = suite.keeper.MintNFT(suite.ctx, denomID, tokenID3, tokenNm3, tokenURI, tokenData, address)
+	err = suite.keeper.MintNFT(suite.ctx, denomID, tokenID3, tokenNm3, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
 	suite.NoError(err)
 
 	nfts := suite.keeper.GetNFTs(suite.ctx, denomID2)
@@ -49,7 +50,7 @@ func (suite *KeeperSuite) TestGetNFTs() {
 }
 
 func (suite *KeeperSuite) TestAuthorize() {
-	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenData, address)
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
 	suite.NoError(err)
 
 	_, err = suite.keeper.Authorize(suite.ctx, denomID, tokenID, address2)
@@ -65,10 +66,36 @@ func (suite *KeeperSuite) TestHasNFT() {
 	suite.False(isNFT)
 
 	// MintNFT shouldn't fail when collection does not exist
-	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenData, address)
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
 	suite.NoError(err)
 
 	// IsNFT should return true
 	isNFT = suite.keeper.HasNFT(suite.ctx, denomID, tokenID)
 	suite.True(isNFT)
 }
+
+func (suite *KeeperSuite) TestIterateNFTs() {
+	for _, id := range []string{tokenID, tokenID2, tokenID3} {
+		err := suite.keeper.MintNFT(suite.ctx, denomID, id, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
+		suite.NoError(err)
+	}
+
+	var ids []string
+	suite.keeper.IterateNFTs(suite.ctx, denomID, func(nft types.BaseNFT) bool {
+		ids = append(ids, nft.GetID())
+		return false
+	})
+	suite.Equal([]string{tokenID, tokenID2, tokenID3}, ids)
+
+	ids = nil
+	suite.keeper.IterateNFTs(suite.ctx, denomID, func(nft types.BaseNFT) bool {
+		ids = append(ids, nft.GetID())
+		return len(ids) == 2
+	})
+	suite.Equal([]string{tokenID, tokenID2}, ids)
+
+	suite.keeper.IterateNFTs(suite.ctx, denomID2, func(nft types.BaseNFT) bool {
+		suite.Fail("denom without NFTs iterated", nft.GetID())
+		return true
+	})
+}
@@ -1,7 +1,12 @@
 package keeper
 
 import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/irismod/nft/types"
 )
@@ -36,30 +41,92 @@ func (k Keeper) GetOwner(ctx sdk.Context, address sdk.AccAddress, denom string)
 	return owner
 }
 
-// GetOwner gets all the ID Collections
+// GetOwners gets all the ID Collections, grouped by owner in the order of the
+// owner index. It holds the whole index in memory, IterateOwnerCollections
+// returns the owners one at a time.
 func (k Keeper) GetOwners(ctx sdk.Context) (owners types.Owners) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), "get_owners")
+
+	k.IterateOwnerCollections(ctx, func(owner types.Owner) bool {
+		owners = append(owners, owner)
+		return false
+	})
+	return owners
+}
+
+// IterateOwnerCollections calls cb on the ID Collections of every owner in the
+// order of the owner index, until cb returns true. The owner index being
+// sorted by owner, the entries of an owner are consecutive and only the ID
+// Collections of one owner are held at a time.
+func (k Keeper) IterateOwnerCollections(ctx sdk.Context, cb func(owner types.Owner) (stop bool)) {
+	var owner types.Owner
+	stopped := false
+	k.IterateOwners(ctx, func(address sdk.AccAddress, denomID, tokenID string) bool {
+		if !owner.Address.Equals(address) {
+			if len(owner.IDCollections) > 0 && cb(owner) {
+				stopped = true
+				return true
+			}
+			owner = types.Owner{Address: address}
+		}
+		owner.IDCollections = types.IDCollections(owner.IDCollections).Add(denomID, tokenID)
+		return false
+	})
+	if !stopped && len(owner.IDCollections) > 0 {
+		cb(owner)
+	}
+}
+
+// GetPaginateOwners returns a page of the owner index grouped by owner like
+// GetOwners, restricted to the NFTs of the denom when not empty. The page
+// limit counts the NFTs, so the NFTs of an owner may span consecutive pages.
+func (k Keeper) GetPaginateOwners(ctx sdk.Context,
+	denomID string,
+	pagination *query.PageRequest) (types.Owners, *query.PageResponse, error) {
+	store := ctx.KVStore(k.storeKey)
+	ownerPrefix := types.KeyOwner(nil, "", "")
+	ownerStore := prefix.NewStore(store, ownerPrefix)
+
+	var owners types.Owners
+	pageRes, err := query.FilteredPaginate(ownerStore, pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		address, denom, tokenID, err := types.SplitKeyOwner(append(append([]byte{}, ownerPrefix...), key...))
+		if err != nil || (len(denomID) > 0 && denom != denomID) {
+			return false, nil
+		}
+		if !accumulate {
+			return true, nil
+		}
+
+		// the index is ordered by owner, the NFTs of an owner are consecutive
+		if n := len(owners); n == 0 || !owners[n-1].Address.Equals(address) {
+			owners = append(owners, types.Owner{Address: address})
+		}
+		owner := &owners[len(owners)-1]
+		owner.IDCollections = types.IDCollections(owner.IDCollections).Add(denom, tokenID)
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return owners, pageRes, nil
+}
+
+// IterateOwners calls cb on every entry of the owner index, grouped by owner
+// then denom, until cb returns true
+func (k Keeper) IterateOwners(ctx sdk.Context, cb func(owner sdk.AccAddress, denomID, tokenID string) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
-	iterator := sdk.KVStoreReversePrefixIterator(store, types.KeyOwner(nil, "", ""))
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyOwner(nil, "", ""))
 	defer iterator.Close()
 
-	idcsMap := make(map[string]types.IDCollections)
 	for ; iterator.Valid(); iterator.Next() {
-		key := iterator.Key()
-		address, denom, id, _ := types.SplitKeyOwner(key)
-		if _, ok := idcsMap[address.String()]; !ok {
-			idcsMap[address.String()] = types.IDCollections{}
-			owners = append(owners, types.Owner{
-				Address: address,
-			})
+		owner, denomID, tokenID, err := types.SplitKeyOwner(iterator.Key())
+		if err != nil {
+			continue
+		}
+		if cb(owner, denomID, tokenID) {
+			break
 		}
-		idcs := idcsMap[address.String()]
-		idcs = idcs.Add(denom, id)
-		idcsMap[address.String()] = idcs
-	}
-	for i, owner := range owners {
-		owners[i].IDCollections = idcsMap[owner.Address.String()]
 	}
-	return owners
 }
 
 func (k Keeper) deleteOwner(ctx sdk.Context,
@@ -1,35 +1,72 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/irismod/nft/keeper"
+	"github.com/irismod/nft/types"
 )
 
 func (suite *KeeperSuite) TestGetOwners() {
 
-	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenData, address)
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
 	suite.NoError(err)
 
-	err = suite.keeper.MintNFT(suite.ctx, denomID, tokenID2, tokenNm2, tokenURI, tokenData, address2)
+	err = suite.keeper.MintNFT(suite.ctx, denomID, tokenID2, tokenNm2, tokenURI, tokenURIHash, tokenData, tokenAttributes, address2, address2)
 	suite.NoError(err)
 
-	err = suite.keeper.MintNFT(suite.ctx, denomID, tokenID3, tokenNm3, tokenURI, tokenData, address3)
+	err = suite.keeper.MintNFT(suite.ctx, denomID, tokenID3, tokenNm3, tokenURI, tokenURIHash, tokenData, tokenAttributes, address3, address3)
 	suite.NoError(err)
 
 	owners := suite.keeper.GetOwners(suite.ctx)
 	suite.Equal(3, len(owners))
 
-	err = suite.keeper.MintNFT(suite.ctx, denomID2, tokenID, tokenNm, tokenURI, tokenData, address)
+	err = suite.keeper.MintNFT(suite.ctx, denomID2, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
 	suite.NoError(err)
 
-	err = suite.keeper.MintNFT(suite.ctx, denomID2, tokenID2, tokenNm2, tokenURI, tokenData, address2)
+	err = suite.keeper.MintNFT(suite.ctx, denomID2, tokenID2, tokenNm2, tokenURI, tokenURIHash, tokenData, tokenAttributes, address2, address2)
 	suite.NoError(err)
 
-	err = suite.keeper.MintNFT(suite.ctx, denomID2, tokenID3, tokenNm3, tokenURI, tokenData, address3)
+	err = suite.keeper.MintNFT(suite.ctx, denomID2, tokenID3, tokenNm3, tokenURI, tokenURIHash, tokenData, tokenAttributes, address3, address3)
 	suite.NoError(err)
 
 	owners = suite.keeper.GetOwners(suite.ctx)
 	suite.Equal(3, len(owners))
 
+	// the owners are iterated one at a time with their two denoms
+	var iterated int
+	suite.keeper.IterateOwnerCollections(suite.ctx, func(owner types.Owner) bool {
+		suite.Equal(owners[iterated], owner)
+		suite.Len(owner.IDCollections, 2)
+		iterated++
+		return iterated == 2
+	})
+	suite.Equal(2, iterated)
+
 	msg, fail := keeper.SupplyInvariant(suite.keeper)(suite.ctx)
 	suite.False(fail, msg)
 }
+
+func (suite *KeeperSuite) TestIterateOwners() {
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
+	suite.NoError(err)
+	err = suite.keeper.MintNFT(suite.ctx, denomID2, tokenID2, tokenNm2, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
+	suite.NoError(err)
+	err = suite.keeper.MintNFT(suite.ctx, denomID, tokenID3, tokenNm3, tokenURI, tokenURIHash, tokenData, tokenAttributes, address2, address2)
+	suite.NoError(err)
+
+	owned := make(map[string][]string)
+	suite.keeper.IterateOwners(suite.ctx, func(owner sdk.AccAddress, denom, id string) bool {
+		owned[owner.String()] = append(owned[owner.String()], denom+"/"+id)
+		return false
+	})
+	suite.Equal([]string{denomID + "/" + tokenID, denomID2 + "/" + tokenID2}, owned[address.String()])
+	suite.Equal([]string{denomID + "/" + tokenID3}, owned[address2.String()])
+
+	count := 0
+	suite.keeper.IterateOwners(suite.ctx, func(sdk.AccAddress, string, string) bool {
+		count++
+		return true
+	})
+	suite.Equal(1, count)
+}
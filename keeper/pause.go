@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irismod/nft/types"
+)
+
+// PauseDenom pauses or unpauses the NFTs of the denom, only the creator of
+// the denom is allowed to do so
+func (k Keeper) PauseDenom(ctx sdk.Context, denomID string, paused bool, sender sdk.AccAddress) error {
+	if err := k.authorizeDenomCreator(ctx, denomID, sender); err != nil {
+		return err
+	}
+
+	k.SetDenomPaused(ctx, denomID, paused)
+	return nil
+}
+
+// SetDenomPaused sets the pause flag of the denom
+func (k Keeper) SetDenomPaused(ctx sdk.Context, denomID string, paused bool) {
+	store := ctx.KVStore(k.storeKey)
+	if !paused {
+		store.Delete(types.KeyPaused(denomID))
+		return
+	}
+	store.Set(types.KeyPaused(denomID), []byte{0x01})
+}
+
+// IsDenomPaused returns whether the denom is paused by its creator
+func (k Keeper) IsDenomPaused(ctx sdk.Context, denomID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyPaused(denomID))
+}
+
+// IsModulePaused returns whether governance paused every denom
+func (k Keeper) IsModulePaused(ctx sdk.Context) bool {
+	var paused bool
+	k.paramSpace.GetIfExists(ctx, types.KeyModulePaused, &paused)
+	return paused
+}
+
+// GetPausedDenoms returns the ids of the paused denoms
+func (k Keeper) GetPausedDenoms(ctx sdk.Context) (denomIDs []string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPaused(""))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		denomIDs = append(denomIDs, string(iterator.Key()[len(types.KeyPaused("")):]))
+	}
+	return denomIDs
+}
+
+// assertNotPaused returns an error if the operations on the denom are paused
+func (k Keeper) assertNotPaused(ctx sdk.Context, denomID string) error {
+	if k.IsModulePaused(ctx) {
+		return sdkerrors.Wrap(types.ErrPaused, "nft operations are paused by governance")
+	}
+	if k.IsDenomPaused(ctx, denomID) {
+		return sdkerrors.Wrapf(types.ErrPaused, "denom %s is paused by its creator", denomID)
+	}
+	return nil
+}
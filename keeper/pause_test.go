@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	"github.com/irismod/nft/types"
+)
+
+func (suite *KeeperSuite) TestPauseDenom() {
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
+	suite.NoError(err)
+
+	// only the creator can pause the denom
+	suite.Error(suite.keeper.PauseDenom(suite.ctx, denomID, true, address2))
+	suite.Error(suite.keeper.PauseDenom(suite.ctx, "unknown", true, address))
+	suite.NoError(suite.keeper.PauseDenom(suite.ctx, denomID, true, address))
+
+	err = suite.keeper.MintNFT(suite.ctx, denomID, tokenID2, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
+	suite.True(types.ErrPaused.Is(err))
+	err = suite.keeper.EditNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI2, tokenURIHash, tokenData, tokenAttributes, false, address)
+	suite.True(types.ErrPaused.Is(err))
+	err = suite.keeper.TransferOwner(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, address, address2)
+	suite.True(types.ErrPaused.Is(err))
+	err = suite.keeper.BurnNFT(suite.ctx, denomID, tokenID, address)
+	suite.True(types.ErrPaused.Is(err))
+
+	// the other denoms are not affected
+	err = suite.keeper.MintNFT(suite.ctx, denomID2, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
+	suite.NoError(err)
+
+	response, err := suite.queryClient.Paused(gocontext.Background(), &types.QueryPausedRequest{Denom: denomID})
+	suite.NoError(err)
+	suite.True(response.Paused)
+	suite.True(response.DenomPaused)
+	suite.False(response.ModulePaused)
+	suite.Equal([]string{denomID}, suite.keeper.GetPausedDenoms(suite.ctx))
+
+	suite.NoError(suite.keeper.PauseDenom(suite.ctx, denomID, false, address))
+	err = suite.keeper.TransferOwner(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, address, address2)
+	suite.NoError(err)
+	suite.Empty(suite.keeper.GetPausedDenoms(suite.ctx))
+}
+
+func (suite *KeeperSuite) TestModulePaused() {
+	params := types.DefaultParams()
+	params.Paused = true
+	suite.keeper.SetParams(suite.ctx, params)
+
+	err := suite.keeper.MintNFT(suite.ctx, denomID2, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
+	suite.True(types.ErrPaused.Is(err))
+
+	response, err := suite.queryClient.Paused(gocontext.Background(), &types.QueryPausedRequest{Denom: denomID2})
+	suite.NoError(err)
+	suite.True(response.Paused)
+	suite.False(response.DenomPaused)
+	suite.True(response.ModulePaused)
+
+	suite.keeper.SetParams(suite.ctx, types.DefaultParams())
+	err = suite.keeper.MintNFT(suite.ctx, denomID2, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
+	suite.NoError(err)
+}
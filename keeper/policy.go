@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irismod/nft/types"
+)
+
+var _ types.PolicyLists = Keeper{}
+
+// SetTransferPolicy sets the transfer policy of the denom, only the creator of
+// the denom is allowed to do so. An empty policy removes the restrictions.
+func (k Keeper) SetTransferPolicy(ctx sdk.Context, denomID, policy string, sender sdk.AccAddress) error {
+	if err := k.authorizeDenomCreator(ctx, denomID, sender); err != nil {
+		return err
+	}
+	return k.SetDenomTransferPolicy(ctx, denomID, policy)
+}
+
+// SetDenomTransferPolicy sets the transfer policy of the denom, the policy
+// must be registered in the keeper
+func (k Keeper) SetDenomTransferPolicy(ctx sdk.Context, denomID, policy string) error {
+	store := ctx.KVStore(k.storeKey)
+	if len(policy) == 0 {
+		store.Delete(types.KeyPolicy(denomID))
+		return nil
+	}
+	if k.policies[policy] == nil {
+		return sdkerrors.Wrapf(types.ErrInvalidPolicy, "unknown transfer policy %s", policy)
+	}
+	store.Set(types.KeyPolicy(denomID), []byte(policy))
+	return nil
+}
+
+// HasTransferPolicy returns whether the transfer policy is registered
+func (k Keeper) HasTransferPolicy(name string) bool {
+	return k.policies[name] != nil
+}
+
+// GetTransferPolicy returns the name of the transfer policy of the denom
+func (k Keeper) GetTransferPolicy(ctx sdk.Context, denomID string) string {
+	store := ctx.KVStore(k.storeKey)
+	return string(store.Get(types.KeyPolicy(denomID)))
+}
+
+// GetTransferPolicies returns the transfer policies of every denom
+func (k Keeper) GetTransferPolicies(ctx sdk.Context) (policies []types.DenomTransferPolicy) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPolicy(""))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		policies = append(policies, types.DenomTransferPolicy{
+			DenomId: string(iterator.Key()[len(types.KeyPolicy("")):]),
+			Policy:  string(iterator.Value()),
+		})
+	}
+	return policies
+}
+
+// UpdatePolicyList adds and removes addresses of the allow or deny list of the
+// denom, only the creator of the denom is allowed to do so
+func (k Keeper) UpdatePolicyList(ctx sdk.Context,
+	denomID, list string,
+	add, remove []sdk.AccAddress,
+	sender sdk.AccAddress) error {
+	if err := k.authorizeDenomCreator(ctx, denomID, sender); err != nil {
+		return err
+	}
+	if err := types.ValidatePolicyList(list); err != nil {
+		return err
+	}
+
+	for _, address := range add {
+		k.SetListed(ctx, denomID, list, address, true)
+	}
+	for _, address := range remove {
+		k.SetListed(ctx, denomID, list, address, false)
+	}
+	return nil
+}
+
+// SetListed adds the address to, or removes it from, the policy list of the denom
+func (k Keeper) SetListed(ctx sdk.Context, denomID, list string, address sdk.AccAddress, listed bool) {
+	store := ctx.KVStore(k.storeKey)
+	if !listed {
+		store.Delete(types.KeyPolicyList(denomID, list, address))
+		return
+	}
+	store.Set(types.KeyPolicyList(denomID, list, address), []byte{0x01})
+}
+
+// IsListed implements types.PolicyLists
+func (k Keeper) IsListed(ctx sdk.Context, denomID, list string, address sdk.AccAddress) bool {
+	if address.Empty() {
+		return false
+	}
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyPolicyList(denomID, list, address))
+}
+
+// GetPolicyList returns the addresses of the policy list of the denom
+func (k Keeper) GetPolicyList(ctx sdk.Context, denomID, list string) (addresses []string) {
+	for _, entry := range k.getPolicyAddresses(ctx, types.KeyPolicyList(denomID, list, nil)) {
+		addresses = append(addresses, entry.Address)
+	}
+	return addresses
+}
+
+// GetPolicyAddresses returns the addresses of the policy lists of every denom
+func (k Keeper) GetPolicyAddresses(ctx sdk.Context) []types.PolicyAddress {
+	return k.getPolicyAddresses(ctx, types.KeyPolicyList("", "", nil))
+}
+
+func (k Keeper) getPolicyAddresses(ctx sdk.Context, prefix []byte) (entries []types.PolicyAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		denomID, list, address, err := types.SplitKeyPolicyList(iterator.Key())
+		if err != nil {
+			continue
+		}
+		entries = append(entries, types.PolicyAddress{
+			DenomId: denomID,
+			List:    list,
+			Address: address.String(),
+		})
+	}
+	return entries
+}
+
+// checkTransferPolicy evaluates the transfer policy of the denom, a policy
+// missing from the registered ones rejects every transfer
+func (k Keeper) checkTransferPolicy(ctx sdk.Context, denomID, tokenID string, sender, recipient sdk.AccAddress) error {
+	name := k.GetTransferPolicy(ctx, denomID)
+	if len(name) == 0 {
+		return nil
+	}
+
+	policy, ok := k.policies[name]
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrInvalidPolicy, "transfer policy %s of denom %s is not registered", name, denomID)
+	}
+	return policy.CheckTransfer(ctx, k, denomID, tokenID, sender, recipient)
+}
@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irismod/nft/keeper"
+	"github.com/irismod/nft/types"
+)
+
+// tokenIDPolicy only accepts the token ids starting with "token"
+type tokenIDPolicy struct{}
+
+func (tokenIDPolicy) Name() string { return "tokenid" }
+
+func (tokenIDPolicy) CheckTransfer(_ sdk.Context, _ types.PolicyLists, _, tokenID string, _, _ sdk.AccAddress) error {
+	if len(tokenID) < 5 || tokenID[:5] != "token" {
+		return sdkerrors.Wrap(types.ErrTransferRestricted, tokenID)
+	}
+	return nil
+}
+
+func (suite *KeeperSuite) TestAllowListPolicy() {
+	// only the creator can set the policy
+	suite.Error(suite.keeper.SetTransferPolicy(suite.ctx, denomID, types.PolicyAllowList, address2))
+	suite.Error(suite.keeper.SetTransferPolicy(suite.ctx, denomID, "unknown", address))
+	suite.NoError(suite.keeper.SetTransferPolicy(suite.ctx, denomID, types.PolicyAllowList, address))
+
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address2)
+	suite.True(types.ErrTransferRestricted.Is(err))
+
+	suite.Error(suite.keeper.UpdatePolicyList(suite.ctx, denomID, types.ListAllow, []sdk.AccAddress{address2}, nil, address2))
+	suite.NoError(suite.keeper.UpdatePolicyList(suite.ctx, denomID, types.ListAllow, []sdk.AccAddress{address2}, nil, address))
+
+	err = suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address2)
+	suite.NoError(err)
+
+	err = suite.keeper.TransferOwner(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, address2, address3)
+	suite.True(types.ErrTransferRestricted.Is(err))
+
+	response, err := suite.queryClient.TransferPolicy(gocontext.Background(), &types.QueryTransferPolicyRequest{Denom: denomID})
+	suite.NoError(err)
+	suite.Equal(types.PolicyAllowList, response.Policy)
+	suite.Equal([]string{address2.String()}, response.AllowList)
+	suite.Empty(response.DenyList)
+
+	// removing the policy lifts the restrictions
+	suite.NoError(suite.keeper.SetTransferPolicy(suite.ctx, denomID, "", address))
+	err = suite.keeper.TransferOwner(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, address2, address3)
+	suite.NoError(err)
+}
+
+func (suite *KeeperSuite) TestDenyListPolicy() {
+	suite.NoError(suite.keeper.SetTransferPolicy(suite.ctx, denomID, types.PolicyDenyList, address))
+	suite.NoError(suite.keeper.UpdatePolicyList(suite.ctx, denomID, types.ListDeny, []sdk.AccAddress{address3}, nil, address))
+
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address3)
+	suite.True(types.ErrTransferRestricted.Is(err))
+
+	err = suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address2)
+	suite.NoError(err)
+
+	err = suite.keeper.TransferOwner(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, address2, address3)
+	suite.True(types.ErrTransferRestricted.Is(err))
+
+	suite.NoError(suite.keeper.UpdatePolicyList(suite.ctx, denomID, types.ListDeny, nil, []sdk.AccAddress{address3}, address))
+	err = suite.keeper.TransferOwner(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, address2, address3)
+	suite.NoError(err)
+}
+
+func (suite *KeeperSuite) TestCustomPolicy() {
+	k := keeper.NewKeeper(
+		suite.app.AppCodec(),
+		suite.app.GetKey(types.StoreKey),
+		suite.app.GetSubspace(types.ModuleName),
+		suite.app.AccountKeeper,
+		suite.app.BankKeeper,
+		tokenIDPolicy{},
+	)
+
+	// the policy is unknown to the keeper of the app
+	suite.Error(suite.keeper.SetTransferPolicy(suite.ctx, denomID, "tokenid", address))
+	suite.NoError(k.SetTransferPolicy(suite.ctx, denomID, "tokenid", address))
+
+	err := k.MintNFT(suite.ctx, denomID, "other", tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
+	suite.True(types.ErrTransferRestricted.Is(err))
+
+	err = k.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
+	suite.NoError(err)
+
+	// a policy missing from the keeper rejects the transfers
+	err = suite.keeper.TransferOwner(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, address, address2)
+	suite.True(types.ErrInvalidPolicy.Is(err))
+
+	suite.Panics(func() {
+		keeper.NewKeeper(
+			suite.app.AppCodec(),
+			suite.app.GetKey(types.StoreKey),
+			suite.app.GetSubspace(types.ModuleName),
+			suite.app.AccountKeeper,
+			suite.app.BankKeeper,
+			types.AllowListPolicy{},
+		)
+	})
+}
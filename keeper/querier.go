@@ -88,6 +88,7 @@ func queryCollection(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQue
 	if err != nil {
 		return nil, err
 	}
+	k.resolveTokenURIs(ctx, denom, collection.NFTs)
 
 	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, collection)
 	if err != nil {
@@ -140,6 +141,9 @@ func queryNFT(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerierCdc
 	if err != nil {
 		return nil, sdkerrors.Wrapf(types.ErrUnknownNFT, "invalid NFT %s from collection %s", params.TokenID, params.Denom)
 	}
+	if baseNFT, ok := nft.(types.BaseNFT); ok {
+		nft = k.resolveTokenURI(ctx, denom, baseNFT)
+	}
 
 	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, nft)
 	if err != nil {
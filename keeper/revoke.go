@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irismod/nft/types"
+)
+
+// RevokeNFT lets the creator of a revocable denom burn the NFT, or reclaim it
+// to the recipient when not empty, whoever owns it. The storage deposit is
+// refunded to the owner and a reclaimed NFT is charged to the creator.
+func (k Keeper) RevokeNFT(ctx sdk.Context,
+	denomID, tokenID string,
+	sender, recipient sdk.AccAddress) error {
+	if err := k.assertNotPaused(ctx, denomID); err != nil {
+		return err
+	}
+
+	nft, err := k.AuthorizeRevoke(ctx, denomID, tokenID, sender)
+	if err != nil {
+		return err
+	}
+
+	if recipient.Empty() {
+		return k.burnNFT(ctx, denomID, nft, types.HistoryActionRevoke)
+	}
+
+	owner := nft.GetOwner()
+	nft.Owner = recipient
+
+	size := k.setNFT(ctx, denomID, nft)
+	k.swapOwner(ctx, denomID, tokenID, owner, recipient)
+	k.appendHistory(ctx, denomID, tokenID, types.HistoryActionRevoke, owner, recipient)
+	if err := k.refundDeposit(ctx, denomID, tokenID, owner); err != nil {
+		return err
+	}
+	return k.adjustDeposit(ctx, denomID, tokenID, size, sender)
+}
+
+// AuthorizeRevoke checks that the sender is the creator of the revocable denom
+// of the NFT, in place of the owner check of Authorize
+func (k Keeper) AuthorizeRevoke(ctx sdk.Context,
+	denomID, tokenID string,
+	sender sdk.AccAddress) (types.BaseNFT, error) {
+	denom, err := k.GetDenom(ctx, denomID)
+	if err != nil {
+		return types.BaseNFT{}, err
+	}
+	if !denom.Revocable {
+		return types.BaseNFT{}, sdkerrors.Wrapf(types.ErrUnauthorized, "denom %s is not revocable", denomID)
+	}
+	if !sender.Equals(denom.Creator) {
+		return types.BaseNFT{}, sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not the creator of %s", sender, denomID)
+	}
+
+	nft, err := k.GetNFT(ctx, denomID, tokenID)
+	if err != nil {
+		return types.BaseNFT{}, err
+	}
+	return nft.(types.BaseNFT), nil
+}
@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irismod/nft/keeper"
+	"github.com/irismod/nft/types"
+)
+
+const revocableDenomID = "revocable"
+
+func (suite *KeeperSuite) TestRevokeNFT() {
+	err := suite.keeper.IssueDenom(suite.ctx, revocableDenomID, revocableDenomID, schema, denomURI, denomURIHash, "", 0, true, address)
+	suite.NoError(err)
+	err = suite.keeper.MintNFT(suite.ctx, revocableDenomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address2)
+	suite.NoError(err)
+	err = suite.keeper.MintNFT(suite.ctx, revocableDenomID, tokenID2, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address2)
+	suite.NoError(err)
+
+	// only the creator can revoke
+	err = suite.keeper.RevokeNFT(suite.ctx, revocableDenomID, tokenID, address3, nil)
+	suite.True(types.ErrUnauthorized.Is(err))
+
+	// reclaim the token
+	suite.NoError(suite.keeper.RevokeNFT(suite.ctx, revocableDenomID, tokenID, address, address))
+	nft, err := suite.keeper.GetNFT(suite.ctx, revocableDenomID, tokenID)
+	suite.NoError(err)
+	suite.Equal(address, nft.GetOwner())
+	suite.Equal(uint64(1), suite.keeper.GetTotalSupplyOfOwner(suite.ctx, revocableDenomID, address2))
+
+	// burn the token
+	suite.NoError(suite.keeper.RevokeNFT(suite.ctx, revocableDenomID, tokenID2, address, nil))
+	suite.False(suite.keeper.HasNFT(suite.ctx, revocableDenomID, tokenID2))
+	suite.Equal(uint64(0), suite.keeper.GetTotalSupplyOfOwner(suite.ctx, revocableDenomID, address2))
+
+	entries, _, err := suite.keeper.GetHistory(suite.ctx, revocableDenomID, tokenID2, nil)
+	suite.NoError(err)
+	suite.Equal(types.HistoryActionRevoke, entries[len(entries)-1].Action)
+
+	msg, fail := keeper.SupplyInvariant(suite.keeper)(suite.ctx)
+	suite.False(fail, msg)
+}
+
+func (suite *KeeperSuite) TestRevokeNFTNotRevocable() {
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address2)
+	suite.NoError(err)
+
+	err = suite.keeper.RevokeNFT(suite.ctx, denomID, tokenID, address, nil)
+	suite.True(types.ErrUnauthorized.Is(err))
+	suite.True(suite.keeper.HasNFT(suite.ctx, denomID, tokenID))
+}
+
+func (suite *KeeperSuite) TestRevokeNFTDeposit() {
+	initial := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000))
+	suite.NoError(suite.app.BankKeeper.SetBalances(suite.ctx, address, initial))
+	suite.keeper.SetParams(suite.ctx, types.NewParams(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false))
+
+	err := suite.keeper.IssueDenom(suite.ctx, revocableDenomID, revocableDenomID, schema, denomURI, denomURIHash, "", 0, true, address)
+	suite.NoError(err)
+	suite.NoError(suite.app.BankKeeper.SetBalances(suite.ctx, address2, initial))
+	err = suite.keeper.MintNFT(suite.ctx, revocableDenomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address2, address2)
+	suite.NoError(err)
+	deposit, _ := suite.keeper.GetDeposit(suite.ctx, revocableDenomID, tokenID)
+
+	// the holder is refunded and the creator locks the deposit of the reclaimed token
+	suite.NoError(suite.keeper.RevokeNFT(suite.ctx, revocableDenomID, tokenID, address, address))
+	suite.Equal(initial, suite.app.BankKeeper.GetAllBalances(suite.ctx, address2))
+	suite.Equal(initial.Sub(sdk.NewCoins(deposit)), suite.app.BankKeeper.GetAllBalances(suite.ctx, address))
+}
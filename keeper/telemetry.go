@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/armon/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irismod/nft/exported"
+	"github.com/irismod/nft/types"
+)
+
+const (
+	// MetricLabelDenom is the label carrying the denom of the NFT metrics
+	MetricLabelDenom = "denom"
+
+	// MetricDenomOther is the denom label of the denoms missing from the allow-list
+	MetricDenomOther = "other"
+)
+
+// TelemetryConfig defines the telemetry settings of the nft module
+type TelemetryConfig struct {
+	// Denoms is the allow-list of the denoms labelled individually, the metrics
+	// of the other denoms are aggregated under the "other" label so the number
+	// of series stays bounded whatever the number of denoms
+	Denoms []string `mapstructure:"telemetry-denoms"`
+}
+
+// WithTelemetry returns a copy of the keeper labelling the metrics of the
+// denoms allowed by the config
+func (k Keeper) WithTelemetry(config TelemetryConfig) Keeper {
+	k.metricDenoms = make(map[string]bool, len(config.Denoms))
+	for _, denomID := range config.Denoms {
+		k.metricDenoms[strings.ToLower(strings.TrimSpace(denomID))] = true
+	}
+	return k
+}
+
+// denomLabel returns the label of the denom, bounded by the allow-list
+func (k Keeper) denomLabel(denomID string) metrics.Label {
+	if !k.metricDenoms[denomID] {
+		return telemetry.NewLabel(MetricLabelDenom, MetricDenomOther)
+	}
+	return telemetry.NewLabel(MetricLabelDenom, denomID)
+}
+
+// incrDenomCounter increments the counter of the operation on the denom
+func (k Keeper) incrDenomCounter(denomID string, val float32, keys ...string) {
+	telemetry.IncrCounterWithLabels(
+		append([]string{types.ModuleName}, keys...),
+		val,
+		[]metrics.Label{k.denomLabel(denomID)},
+	)
+}
+
+// setSupplyGauge reports the total supply of the denom, the denoms missing
+// from the allow-list are skipped as their supplies can't be aggregated
+func (k Keeper) setSupplyGauge(denomID string, supply uint64) {
+	if !k.metricDenoms[denomID] {
+		return
+	}
+	telemetry.SetGaugeWithLabels(
+		[]string{types.ModuleName, "supply"},
+		float32(supply),
+		[]metrics.Label{k.denomLabel(denomID)},
+	)
+}
+
+// recordOperation reports the metrics of an operation on the denom, the bytes
+// of the NFT it stored when not nil and the supply when it changed. It is
+// called once the operation succeeded and skipped in CheckTx and simulation,
+// whose state is discarded.
+func (k Keeper) recordOperation(ctx sdk.Context, denomID, operation string, stored exported.NFT, supplyChanged bool) {
+	if ctx.IsCheckTx() {
+		return
+	}
+
+	k.incrDenomCounter(denomID, 1, operation)
+	if stored != nil {
+		nft := stored.(types.BaseNFT)
+		k.incrDenomCounter(denomID, float32(len(k.cdc.MustMarshalBinaryBare(&nft))), "bytes_written")
+	}
+	if supplyChanged {
+		k.setSupplyGauge(denomID, k.GetTotalSupply(ctx, denomID))
+	}
+}
@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/armon/go-metrics"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irismod/nft/keeper"
+	"github.com/irismod/nft/types"
+)
+
+func (suite *KeeperSuite) TestTelemetryDenomAllowList() {
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	cfg := metrics.DefaultConfig("")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	suite.NoError(err)
+	defer metrics.NewGlobal(metrics.DefaultConfig(""), &metrics.BlackholeSink{})
+
+	msgServer := keeper.NewMsgServerImpl(suite.keeper.WithTelemetry(keeper.TelemetryConfig{Denoms: []string{denomID}}))
+	mint := func(ctx sdk.Context, denomID, tokenID string) error {
+		_, err := msgServer.MintNFT(sdk.WrapSDKContext(ctx), types.NewMsgMintNFT(tokenID, denomID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address.String(), address.String()))
+		return err
+	}
+
+	// neither CheckTx nor a failing operation is counted
+	checkCtx, _ := suite.ctx.CacheContext()
+	suite.NoError(mint(checkCtx.WithIsCheckTx(true), denomID, tokenID))
+	suite.Error(mint(suite.ctx, "unknown", tokenID))
+
+	suite.NoError(mint(suite.ctx, denomID, tokenID))
+	suite.NoError(mint(suite.ctx, denomID2, tokenID))
+	_, err = msgServer.BurnNFT(sdk.WrapSDKContext(suite.ctx), types.NewMsgBurnNFT(address.String(), tokenID, denomID2))
+	suite.NoError(err)
+
+	intervals := sink.Data()
+	suite.Len(intervals, 1)
+	counters := intervals[0].Counters
+	gauges := intervals[0].Gauges
+
+	suite.Equal(1, counters["nft.mint;denom="+denomID].Count)
+	suite.Contains(counters, "nft.mint;denom="+keeper.MetricDenomOther)
+	suite.Contains(counters, "nft.burn;denom="+keeper.MetricDenomOther)
+	suite.NotContains(counters, "nft.mint;denom="+denomID2)
+	suite.Contains(counters, "nft.bytes_written;denom="+denomID)
+
+	suite.Contains(gauges, "nft.supply;denom="+denomID)
+	suite.Equal(float32(1), gauges["nft.supply;denom="+denomID].Value)
+	suite.Len(gauges, 1)
+}
@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irismod/nft/keeper"
+	"github.com/irismod/nft/types"
+)
+
+func (suite *KeeperSuite) TestBaseURI() {
+	denom := "baseuri"
+	err := suite.keeper.IssueDenom(suite.ctx, denom, denom, schema, "", "", "ipfs://cid/", 0, false, address)
+	suite.NoError(err)
+
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	for tokenID, uri := range map[string]string{
+		"kitty1": "",
+		"kitty2": "{id}.json",
+		"kitty3": "https://example.com/kitty3",
+	} {
+		_, err := msgServer.MintNFT(sdk.WrapSDKContext(ctx),
+			types.NewMsgMintNFT(tokenID, denom, tokenNm, uri, "", tokenData, nil, address.String(), address.String()))
+		suite.NoError(err)
+	}
+
+	// the events carry the resolved uris
+	msgs, err := types.ParseTypedEvents(ctx.EventManager().Events().ToABCIEvents())
+	suite.NoError(err)
+	minted := make(map[string]string)
+	for _, msg := range msgs {
+		if mint, ok := msg.(*types.EventMint); ok {
+			minted[mint.TokenId] = mint.TokenURI
+		}
+	}
+	expected := map[string]string{
+		"kitty1": "ipfs://cid/kitty1",
+		"kitty2": "ipfs://cid/kitty2.json",
+		"kitty3": "https://example.com/kitty3",
+	}
+	suite.Equal(expected, minted)
+
+	// and so do the queries
+	for tokenID, uri := range expected {
+		response, err := suite.queryClient.NFT(gocontext.Background(), &types.QueryNFTRequest{Denom: denom, Id: tokenID})
+		suite.NoError(err)
+		suite.Equal(uri, response.NFT.URI)
+	}
+
+	response, err := suite.queryClient.Collection(gocontext.Background(), &types.QueryCollectionRequest{Denom: denom})
+	suite.NoError(err)
+	suite.Len(response.Collection.NFTs, 3)
+	for _, nft := range response.Collection.NFTs {
+		suite.Equal(expected[nft.Id], nft.URI)
+	}
+
+	suite.NoError(suite.keeper.EditNFT(suite.ctx, denom, "kitty1", types.DoNotModify, types.DoNotModify, types.DoNotModify, types.DoNotModify,
+		[]types.Attribute{types.NewAttribute("rarity", "legendary", "")}, false, address))
+	byTrait, err := suite.queryClient.NFTsByTrait(gocontext.Background(), &types.QueryNFTsByTraitRequest{Denom: denom, Key: "rarity", Value: "legendary"})
+	suite.NoError(err)
+	suite.Len(byTrait.NFTs, 1)
+	suite.Equal(expected["kitty1"], byTrait.NFTs[0].URI)
+
+	// unless the raw uris are requested
+	response, err = suite.queryClient.Collection(gocontext.Background(), &types.QueryCollectionRequest{Denom: denom, RawURIs: true})
+	suite.NoError(err)
+	raw := make(map[string]string)
+	for _, nft := range response.Collection.NFTs {
+		raw[nft.Id] = nft.URI
+	}
+	suite.Equal(map[string]string{"kitty1": "", "kitty2": "{id}.json", "kitty3": "https://example.com/kitty3"}, raw)
+
+	// the stored uris, exported to genesis, stay relative
+	collection, err := suite.keeper.GetCollection(suite.ctx, denom)
+	suite.NoError(err)
+	for _, nft := range collection.NFTs {
+		if nft.Id == "kitty1" {
+			suite.Empty(nft.URI)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/irismod/nft/types"
+)
+
+// GetNFTsByTrait returns a page of the NFTs of the denom holding the trait key=value
+func (k Keeper) GetNFTsByTrait(ctx sdk.Context,
+	denomID, key, value string,
+	pagination *query.PageRequest) ([]types.BaseNFT, *query.PageResponse, error) {
+	store := ctx.KVStore(k.storeKey)
+	traitStore := prefix.NewStore(store, types.KeyTrait(denomID, key, value, ""))
+
+	var nfts []types.BaseNFT
+	pageRes, err := query.Paginate(traitStore, pagination, func(_ []byte, value []byte) error {
+		tokenID := types.MustUnMarshalTokenID(k.cdc, value)
+		nft, err := k.GetNFT(ctx, denomID, tokenID)
+		if err != nil {
+			return err
+		}
+		nfts = append(nfts, nft.(types.BaseNFT))
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return nfts, pageRes, nil
+}
+
+// GetTraitCount returns the number of NFTs of the denom holding the trait key=value
+func (k Keeper) GetTraitCount(ctx sdk.Context, denomID, key, value string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyTraitCount(denomID, key, value))
+	if len(bz) == 0 {
+		return 0
+	}
+	return types.MustUnMarshalSupply(k.cdc, bz)
+}
+
+// GetTraitHistogram returns a page of the trait counters of the denom,
+// restricted to the values of a single trait when the key is not empty
+func (k Keeper) GetTraitHistogram(ctx sdk.Context,
+	denomID, key string,
+	pagination *query.PageRequest) ([]types.TraitCount, *query.PageResponse, error) {
+	store := ctx.KVStore(k.storeKey)
+	countStore := prefix.NewStore(store, types.KeyTraitCount(denomID, key, ""))
+
+	var traits []types.TraitCount
+	pageRes, err := query.Paginate(countStore, pagination, func(k0 []byte, value []byte) error {
+		traitKey, traitValue := key, string(k0)
+		if len(key) == 0 {
+			var err error
+			if traitKey, traitValue, err = types.SplitKeyTraitCount(k0); err != nil {
+				return err
+			}
+		}
+		traits = append(traits, types.TraitCount{
+			Key:   traitKey,
+			Value: traitValue,
+			Count: types.MustUnMarshalSupply(k.cdc, value),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return traits, pageRes, nil
+}
+
+// setTraits adds the NFT to the trait index of the denom
+func (k Keeper) setTraits(ctx sdk.Context, denomID string, nft types.BaseNFT) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalTokenID(k.cdc, nft.GetID())
+	for _, attr := range nft.GetAttributes() {
+		store.Set(types.KeyTrait(denomID, attr.Key, attr.Value, nft.GetID()), bz)
+		k.setTraitCount(ctx, denomID, attr.Key, attr.Value, k.GetTraitCount(ctx, denomID, attr.Key, attr.Value)+1)
+	}
+}
+
+// deleteTraits removes the NFT from the trait index of the denom
+func (k Keeper) deleteTraits(ctx sdk.Context, denomID string, nft types.BaseNFT) {
+	store := ctx.KVStore(k.storeKey)
+	for _, attr := range nft.GetAttributes() {
+		store.Delete(types.KeyTrait(denomID, attr.Key, attr.Value, nft.GetID()))
+		if count := k.GetTraitCount(ctx, denomID, attr.Key, attr.Value); count > 0 {
+			k.setTraitCount(ctx, denomID, attr.Key, attr.Value, count-1)
+		}
+	}
+}
+
+func (k Keeper) setTraitCount(ctx sdk.Context, denomID, key, value string, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if count == 0 {
+		store.Delete(types.KeyTraitCount(denomID, key, value))
+		return
+	}
+
+	bz := types.MustMarshalSupply(k.cdc, count)
+	store.Set(types.KeyTraitCount(denomID, key, value), bz)
+}
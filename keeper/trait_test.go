@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/irismod/nft/types"
+)
+
+func (suite *KeeperSuite) TestNFTsByTrait() {
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
+	suite.NoError(err)
+
+	err = suite.keeper.MintNFT(suite.ctx, denomID, tokenID2, tokenNm2, tokenURI, tokenURIHash, tokenData, tokenAttributes, address2, address2)
+	suite.NoError(err)
+
+	err = suite.keeper.MintNFT(suite.ctx, denomID2, tokenID3, tokenNm3, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
+	suite.NoError(err)
+
+	response, err := suite.queryClient.NFTsByTrait(gocontext.Background(), &types.QueryNFTsByTraitRequest{
+		Denom: denomID,
+		Key:   "rarity",
+		Value: "legendary",
+	})
+	suite.NoError(err)
+	suite.Len(response.NFTs, 2)
+	suite.Equal(tokenID, response.NFTs[0].GetID())
+	suite.Equal(tokenID2, response.NFTs[1].GetID())
+
+	// paginated
+	response, err = suite.queryClient.NFTsByTrait(gocontext.Background(), &types.QueryNFTsByTraitRequest{
+		Denom:      denomID,
+		Key:        "rarity",
+		Value:      "legendary",
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	suite.NoError(err)
+	suite.Len(response.NFTs, 1)
+	suite.NotNil(response.Pagination.NextKey)
+
+	// the index follows edits
+	err = suite.keeper.EditNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData,
+		[]types.Attribute{types.NewAttribute("rarity", "common", "")}, false, address)
+	suite.NoError(err)
+
+	nfts, _, err := suite.keeper.GetNFTsByTrait(suite.ctx, denomID, "rarity", "legendary", nil)
+	suite.NoError(err)
+	suite.Len(nfts, 1)
+	suite.Equal(tokenID2, nfts[0].GetID())
+
+	nfts, _, err = suite.keeper.GetNFTsByTrait(suite.ctx, denomID, "rarity", "common", nil)
+	suite.NoError(err)
+	suite.Len(nfts, 1)
+	suite.Equal(tokenID, nfts[0].GetID())
+
+	// the index is kept when the attributes are not modified
+	err = suite.keeper.EditNFT(suite.ctx, denomID, tokenID, tokenNm2, tokenURI, tokenURIHash, tokenData, types.DoNotModifyAttributes(), false, address)
+	suite.NoError(err)
+
+	nfts, _, err = suite.keeper.GetNFTsByTrait(suite.ctx, denomID, "rarity", "common", nil)
+	suite.NoError(err)
+	suite.Len(nfts, 1)
+
+	// as when the attributes are left out
+	err = suite.keeper.EditNFT(suite.ctx, denomID, tokenID, tokenNm2, tokenURI, tokenURIHash, tokenData, nil, false, address)
+	suite.NoError(err)
+
+	nfts, _, err = suite.keeper.GetNFTsByTrait(suite.ctx, denomID, "rarity", "common", nil)
+	suite.NoError(err)
+	suite.Len(nfts, 1)
+
+	// clearing the attributes removes the nft from the index
+	err = suite.keeper.EditNFT(suite.ctx, denomID, tokenID, tokenNm2, tokenURI, tokenURIHash, tokenData, nil, true, address)
+	suite.NoError(err)
+
+	nft, err := suite.keeper.GetNFT(suite.ctx, denomID, tokenID)
+	suite.NoError(err)
+	suite.Empty(nft.(types.BaseNFT).GetAttributes())
+	nfts, _, err = suite.keeper.GetNFTsByTrait(suite.ctx, denomID, "rarity", "common", nil)
+	suite.NoError(err)
+	suite.Empty(nfts)
+	suite.Equal(uint64(0), suite.keeper.GetTraitCount(suite.ctx, denomID, "rarity", "common"))
+
+	// and the index entries are removed on burn
+	err = suite.keeper.BurnNFT(suite.ctx, denomID, tokenID2, address2)
+	suite.NoError(err)
+
+	nfts, _, err = suite.keeper.GetNFTsByTrait(suite.ctx, denomID, "rarity", "legendary", nil)
+	suite.NoError(err)
+	suite.Empty(nfts)
+}
+
+func (suite *KeeperSuite) TestTraitHistogram() {
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
+	suite.NoError(err)
+
+	err = suite.keeper.MintNFT(suite.ctx, denomID, tokenID2, tokenNm2, tokenURI, tokenURIHash, tokenData,
+		[]types.Attribute{types.NewAttribute("rarity", "common", "")}, address, address)
+	suite.NoError(err)
+
+	err = suite.keeper.MintNFT(suite.ctx, denomID, tokenID3, tokenNm3, tokenURI, tokenURIHash, tokenData, tokenAttributes, address, address)
+	suite.NoError(err)
+
+	response, err := suite.queryClient.TraitHistogram(gocontext.Background(), &types.QueryTraitHistogramRequest{
+		Denom: denomID,
+	})
+	suite.NoError(err)
+	suite.Equal([]types.TraitCount{
+		{Key: "level", Value: "3", Count: 2},
+		{Key: "rarity", Value: "common", Count: 1},
+		{Key: "rarity", Value: "legendary", Count: 2},
+	}, response.Traits)
+
+	response, err = suite.queryClient.TraitHistogram(gocontext.Background(), &types.QueryTraitHistogramRequest{
+		Denom: denomID,
+		Key:   "rarity",
+	})
+	suite.NoError(err)
+	suite.Equal([]types.TraitCount{
+		{Key: "rarity", Value: "common", Count: 1},
+		{Key: "rarity", Value: "legendary", Count: 2},
+	}, response.Traits)
+
+	err = suite.keeper.BurnNFT(suite.ctx, denomID, tokenID2, address)
+	suite.NoError(err)
+	suite.Equal(uint64(0), suite.keeper.GetTraitCount(suite.ctx, denomID, "rarity", "common"))
+}
@@ -0,0 +1,62 @@
+package nft
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irismod/nft/keeper"
+	"github.com/irismod/nft/types"
+)
+
+// ConsensusVersion is the consensus version of the NFT module, it is bumped
+// with a new migration on every breaking change of the store
+const ConsensusVersion = 2
+
+// MigrationHandler migrates the store of the module from a consensus version
+// to the next one
+type MigrationHandler func(ctx sdk.Context) error
+
+// Configurator holds the store migrations of the module by the consensus
+// version they migrate from. The cosmos-sdk pinned by this module has no
+// module configurator nor version map, the consensus version is thus kept in
+// the store of the module and the migrations are run by the upgrade handler
+// of the app through AppModule.RunMigrations.
+type Configurator struct {
+	migrations map[uint64]MigrationHandler
+}
+
+// NewConfigurator creates a Configurator without any migration
+func NewConfigurator() Configurator {
+	return Configurator{migrations: make(map[uint64]MigrationHandler)}
+}
+
+// RegisterMigration registers the migration of the store from the consensus
+// version fromVersion to fromVersion+1
+func (c Configurator) RegisterMigration(fromVersion uint64, handler MigrationHandler) error {
+	if fromVersion == 0 || fromVersion >= ConsensusVersion {
+		return sdkerrors.Wrapf(types.ErrMigration, "invalid version %d to migrate from", fromVersion)
+	}
+	if _, ok := c.migrations[fromVersion]; ok {
+		return sdkerrors.Wrapf(types.ErrMigration, "migration from version %d is already registered", fromVersion)
+	}
+	c.migrations[fromVersion] = handler
+	return nil
+}
+
+// RunMigrations runs in order the migrations from the consensus version of
+// the store up to ConsensusVersion, the store is left untouched if one fails
+func (c Configurator) RunMigrations(ctx sdk.Context, k keeper.Keeper) error {
+	cacheCtx, write := ctx.CacheContext()
+	for version := k.GetConsensusVersion(cacheCtx); version < ConsensusVersion; version++ {
+		handler, ok := c.migrations[version]
+		if !ok {
+			return sdkerrors.Wrapf(types.ErrMigration, "no migration registered from version %d", version)
+		}
+		if err := handler(cacheCtx); err != nil {
+			return sdkerrors.Wrapf(types.ErrMigration, "migration from version %d: %s", version, err)
+		}
+		k.SetConsensusVersion(cacheCtx, version+1)
+	}
+	write()
+	return nil
+}
@@ -0,0 +1,165 @@
+package v2
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irismod/nft/types"
+)
+
+var delimiter = []byte("/")
+
+// segments are the number of leading key segments holding an id for every
+// v1 prefix keyed by a denom or token id, the rest of the key is kept as is.
+// The prefixes added since v1 are only ever written with lowercased ids.
+var segments = []struct {
+	prefix []byte
+	count  int
+	ids    []int
+}{
+	{types.PrefixDenom, 1, []int{0}},
+	{types.PrefixNFT, 2, []int{0, 1}},
+	{types.PrefixOwners, 3, []int{1, 2}},
+}
+
+// MigrateStore performs in-place store migrations from v1 to v2:
+//
+// - the denom and token ids stored by a v1 genesis were not lowercased unlike
+// the ones of the messages, they are rewritten in lowercase in every key and
+// value holding them, two ids only differing by their case fail the migration
+// - the supply keys are rebuilt from the stored NFTs, which drops the ones of
+// unknown denoms and the ones left at zero
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.Marshaler) error {
+	store := ctx.KVStore(storeKey)
+
+	for _, s := range segments {
+		if err := migrateIDs(store, cdc, s.prefix, s.count, s.ids); err != nil {
+			return err
+		}
+	}
+	migrateDenomNames(store)
+	migrateSupply(store, cdc)
+	return nil
+}
+
+// migrateIDs lowercases the id segments of the keys under the prefix, the
+// rewrites are collected first as the store can't be written while iterated
+func migrateIDs(store sdk.KVStore, cdc codec.Marshaler, prefix []byte, count int, ids []int) error {
+	type rewrite struct {
+		oldKey, newKey, value []byte
+	}
+
+	var rewrites []rewrite
+	iterator := sdk.KVStorePrefixIterator(store, append(prefix, delimiter...))
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		newKey := lowerKey(key, len(prefix)+len(delimiter), count, ids)
+		if bytes.Equal(key, newKey) {
+			continue
+		}
+		rewrites = append(rewrites, rewrite{oldKey: key, newKey: newKey, value: iterator.Value()})
+	}
+	iterator.Close()
+
+	for _, r := range rewrites {
+		store.Delete(r.oldKey)
+	}
+	for _, r := range rewrites {
+		if store.Has(r.newKey) {
+			return fmt.Errorf("key %X collides with %X once lowercased", r.oldKey, r.newKey)
+		}
+		value, err := lowerValue(cdc, prefix, r.value)
+		if err != nil {
+			return err
+		}
+		store.Set(r.newKey, value)
+	}
+	return nil
+}
+
+// lowerKey lowercases the id segments among the first count segments of the
+// key following the prefix
+func lowerKey(key []byte, prefixLen, count int, ids []int) []byte {
+	parts := bytes.SplitN(key[prefixLen:], delimiter, count+1)
+	for _, i := range ids {
+		if i < len(parts) {
+			parts[i] = bytes.ToLower(parts[i])
+		}
+	}
+
+	newKey := append([]byte{}, key[:prefixLen]...)
+	return append(newKey, bytes.Join(parts, delimiter)...)
+}
+
+// lowerValue lowercases the id held by the values of the denoms, the NFTs and
+// the owner index
+func lowerValue(cdc codec.Marshaler, prefix, value []byte) ([]byte, error) {
+	switch {
+	case bytes.Equal(prefix, types.PrefixDenom):
+		var denom types.Denom
+		if err := cdc.UnmarshalBinaryBare(value, &denom); err != nil {
+			return nil, err
+		}
+		denom.Id = strings.ToLower(denom.Id)
+		return cdc.MarshalBinaryBare(&denom)
+	case bytes.Equal(prefix, types.PrefixNFT):
+		var nft types.BaseNFT
+		if err := cdc.UnmarshalBinaryBare(value, &nft); err != nil {
+			return nil, err
+		}
+		nft.Id = strings.ToLower(nft.Id)
+		return cdc.MarshalBinaryBare(&nft)
+	case bytes.Equal(prefix, types.PrefixOwners):
+		return types.MustMarshalTokenID(cdc, strings.ToLower(types.MustUnMarshalTokenID(cdc, value))), nil
+	}
+	return value, nil
+}
+
+// migrateDenomNames lowercases the denom ids the denom names point to
+func migrateDenomNames(store sdk.KVStore) {
+	iterator := sdk.KVStorePrefixIterator(store, append(types.PrefixDenomName, delimiter...))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if id := bytes.ToLower(iterator.Value()); !bytes.Equal(id, iterator.Value()) {
+			store.Set(iterator.Key(), id)
+		}
+	}
+}
+
+// migrateSupply replaces the supply keys by the number of NFTs of every denom
+func migrateSupply(store sdk.KVStore, cdc codec.Marshaler) {
+	var stale [][]byte
+	iterator := sdk.KVStorePrefixIterator(store, append(types.PrefixCollection, delimiter...))
+	for ; iterator.Valid(); iterator.Next() {
+		stale = append(stale, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range stale {
+		store.Delete(key)
+	}
+
+	supplies := make(map[string]uint64)
+	var denomIDs []string
+	iterator = sdk.KVStorePrefixIterator(store, append(types.PrefixNFT, delimiter...))
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.PrefixNFT)+len(delimiter):]
+		denomID := string(bytes.SplitN(key, delimiter, 2)[0])
+		if _, ok := supplies[denomID]; !ok {
+			denomIDs = append(denomIDs, denomID)
+		}
+		supplies[denomID]++
+	}
+	iterator.Close()
+
+	for _, denomID := range denomIDs {
+		if !store.Has(types.KeyDenomID(denomID)) {
+			continue
+		}
+		store.Set(types.KeyCollection(denomID), types.MustMarshalSupply(cdc, supplies[denomID]))
+	}
+}
@@ -0,0 +1,138 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/irismod/nft"
+	simapp "github.com/irismod/nft/app"
+	"github.com/irismod/nft/keeper"
+	v2 "github.com/irismod/nft/migrations/v2"
+	"github.com/irismod/nft/types"
+)
+
+var owner = sdk.AccAddress("owner_______________")
+
+// setupV1 writes a v1 store, made of the keys and values of the v1 layout
+// only, holding a denom and NFTs imported with mixed case ids, a stale supply
+// and the supply of an unknown denom. The nft param subspace, which v1 has
+// not, is left empty.
+func setupV1() (*simapp.SimApp, sdk.Context) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	cdc := app.AppCodec()
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+
+	// the v1 store and params are wiped as the app writes a v2 genesis
+	clearStore(store)
+	clearStore(prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/")))
+
+	denom := types.Denom{Id: "DenomA", Name: "denomname", Schema: "{}", Creator: owner}
+	store.Set(types.KeyDenomID(denom.Id), cdc.MustMarshalBinaryBare(&denom))
+	store.Set(types.KeyDenomName(denom.Name), []byte(denom.Id))
+
+	for _, id := range []string{"TokenA", "tokenb"} {
+		nft := types.BaseNFT{Id: id, Name: id, URI: "ipfs://" + id, Data: "data", Owner: owner}
+		store.Set(types.KeyNFT(denom.Id, id), cdc.MustMarshalBinaryBare(&nft))
+		store.Set(types.KeyOwner(owner, denom.Id, id), types.MustMarshalTokenID(cdc, id))
+	}
+
+	store.Set(types.KeyCollection(denom.Id), types.MustMarshalSupply(cdc, 5))
+	store.Set(types.KeyCollection("ghost"), types.MustMarshalSupply(cdc, 3))
+	return app, ctx
+}
+
+// clearStore deletes every key of the store
+func clearStore(store sdk.KVStore) {
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func TestMigrateStore(t *testing.T) {
+	app, ctx := setupV1()
+	k := app.NFTKeeper
+	require.NoError(t, v2.MigrateStore(ctx, app.GetKey(types.StoreKey), app.AppCodec()))
+
+	require.False(t, k.HasDenomID(ctx, "DenomA"))
+	denom, err := k.GetDenom(ctx, "denoma")
+	require.NoError(t, err)
+	require.Equal(t, "denoma", denom.Id)
+	require.Equal(t, []byte("denoma"), ctx.KVStore(app.GetKey(types.StoreKey)).Get(types.KeyDenomName("denomname")))
+
+	for _, id := range []string{"tokena", "tokenb"} {
+		nft, err := k.GetNFT(ctx, "denoma", id)
+		require.NoError(t, err)
+		require.Equal(t, id, nft.GetID())
+	}
+	require.False(t, k.HasNFT(ctx, "denoma", "TokenA"))
+	require.Equal(t, uint64(2), k.GetTotalSupplyOfOwner(ctx, "denoma", owner))
+
+	require.Equal(t, uint64(2), k.GetTotalSupply(ctx, "denoma"))
+	require.Equal(t, uint64(0), k.GetTotalSupply(ctx, "ghost"))
+	msg, broken := keeper.SupplyInvariant(k)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestMigrateStoreCollision(t *testing.T) {
+	app, ctx := setupV1()
+	cdc := app.AppCodec()
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+
+	nft := types.BaseNFT{Id: "tokena", Owner: owner}
+	store.Set(types.KeyNFT("DenomA", nft.Id), cdc.MustMarshalBinaryBare(&nft))
+	require.Error(t, v2.MigrateStore(ctx, app.GetKey(types.StoreKey), cdc))
+}
+
+func TestRunMigrations(t *testing.T) {
+	app, ctx := setupV1()
+	k := app.NFTKeeper
+	module := nft.NewAppModule(app.AppCodec(), k, app.AccountKeeper, app.BankKeeper)
+
+	require.Equal(t, uint64(1), k.GetConsensusVersion(ctx))
+	require.NoError(t, module.RunMigrations(ctx))
+	require.Equal(t, uint64(nft.ConsensusVersion), k.GetConsensusVersion(ctx))
+	require.True(t, k.HasDenomID(ctx, "denoma"))
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+	require.NoError(t, k.MintNFT(ctx, "denoma", "tokenc", "tokenc", "ipfs://tokenc", "", "data", nil, owner, owner))
+
+	// a migrated store is left untouched
+	require.NoError(t, module.RunMigrations(ctx))
+	require.Equal(t, uint64(nft.ConsensusVersion), k.GetConsensusVersion(ctx))
+
+	// a failed migration doesn't write anything
+	app, ctx = setupV1()
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	nftA := types.BaseNFT{Id: "tokena", Owner: owner}
+	store.Set(types.KeyNFT("DenomA", nftA.Id), app.AppCodec().MustMarshalBinaryBare(&nftA))
+	module = nft.NewAppModule(app.AppCodec(), app.NFTKeeper, app.AccountKeeper, app.BankKeeper)
+	require.Error(t, module.RunMigrations(ctx))
+	require.Equal(t, uint64(1), app.NFTKeeper.GetConsensusVersion(ctx))
+	require.True(t, app.NFTKeeper.HasDenomID(ctx, "DenomA"))
+}
+
+func TestUpgradeHandler(t *testing.T) {
+	app, ctx := setupV1()
+	k := app.NFTKeeper
+
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: simapp.UpgradeName, Height: ctx.BlockHeight()})
+	require.Equal(t, uint64(nft.ConsensusVersion), k.GetConsensusVersion(ctx))
+	require.True(t, k.HasDenomID(ctx, "denoma"))
+	require.True(t, k.HasNFT(ctx, "denoma", "tokena"))
+	require.Equal(t, uint64(2), k.GetTotalSupply(ctx, "denoma"))
+	require.NoError(t, k.MintNFT(ctx, "denoma", "tokenc", "tokenc", "ipfs://tokenc", "", "data", nil, owner, owner))
+}
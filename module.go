@@ -1,6 +1,7 @@
 package nft
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -104,6 +105,25 @@ func (am AppModule) RegisterQueryService(server grpc.Server) {
 	types.RegisterQueryServer(server, am.keeper)
 }
 
+// ConsensusVersion returns the consensus version of the NFT module.
+func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
+
+// RegisterMigrations registers the store migrations of the NFT module.
+func (am AppModule) RegisterMigrations(cfg Configurator) {
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(1, m.Migrate1to2); err != nil {
+		panic(err)
+	}
+}
+
+// RunMigrations migrates the store of the NFT module up to ConsensusVersion,
+// it is meant to be called by the upgrade handler of the chain.
+func (am AppModule) RunMigrations(ctx sdk.Context) error {
+	cfg := NewConfigurator()
+	am.RegisterMigrations(cfg)
+	return cfg.RunMigrations(ctx, am.keeper)
+}
+
 // NewAppModule creates a new AppModule object
 func NewAppModule(cdc codec.Marshaler, keeper keeper.Keeper, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper) AppModule {
 	return AppModule{
@@ -119,6 +139,7 @@ func (AppModule) Name() string { return types.ModuleName }
 
 // RegisterInvariants registers the NFT module invariants.
 func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
 }
 
 // Route returns the message routing key for the NFT module.
@@ -146,10 +167,13 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONMarshaler, data j
 }
 
 // ExportGenesis returns the exported genesis state as raw bytes for the NFT
-// module.
+// module, the NFTs are encoded one at a time by WriteGenesis.
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONMarshaler) json.RawMessage {
-	gs := ExportGenesis(ctx, am.keeper)
-	return cdc.MustMarshalJSON(gs)
+	var buf bytes.Buffer
+	if err := WriteGenesis(ctx, am.keeper, cdc, &buf); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
 }
 
 // BeginBlock performs a no-op.
@@ -170,9 +194,9 @@ func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	simulation.RandomizedGenState(simState)
 }
 
-// ProposalContents doesn't return any content functions for governance proposals.
-func (AppModule) ProposalContents(simState module.SimulationState) []simtypes.WeightedProposalContent {
-	return nil
+// ProposalContents returns the content moderation proposals for the simulator.
+func (am AppModule) ProposalContents(simState module.SimulationState) []simtypes.WeightedProposalContent {
+	return simulation.ProposalContents(am.keeper)
 }
 
 // RandomizedParams creates randomized NFT param changes for the simulator.
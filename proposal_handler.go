@@ -0,0 +1,92 @@
+package nft
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/irismod/nft/keeper"
+	"github.com/irismod/nft/types"
+)
+
+// NewProposalHandler handles the content moderation proposals of the nft module
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.ForceBurnProposal:
+			return handleForceBurnProposal(ctx, k, c)
+		case *types.HideProposal:
+			return handleHideProposal(ctx, k, c)
+		case *types.ReassignDenomCreatorProposal:
+			return handleReassignDenomCreatorProposal(ctx, k, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized nft proposal content type: %T", c)
+		}
+	}
+}
+
+func handleForceBurnProposal(ctx sdk.Context, k keeper.Keeper, p *types.ForceBurnProposal) error {
+	nft, _ := k.GetNFT(ctx, p.DenomId, p.TokenId)
+	if err := k.ForceBurnNFT(ctx, p.DenomId, p.TokenId); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForceBurnNFT,
+			sdk.NewAttribute(types.AttributeKeyDenom, p.DenomId),
+			sdk.NewAttribute(types.AttributeKeyTokenID, p.TokenId),
+		),
+	)
+	return types.EmitTypedEvent(ctx, &types.EventBurn{
+		DenomId: p.DenomId,
+		TokenId: p.TokenId,
+		Owner:   nft.GetOwner().String(),
+		Forced:  true,
+	})
+}
+
+func handleHideProposal(ctx sdk.Context, k keeper.Keeper, p *types.HideProposal) error {
+	if err := k.SetHidden(ctx, p.DenomId, p.TokenId, p.Hidden); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHide,
+			sdk.NewAttribute(types.AttributeKeyDenom, p.DenomId),
+			sdk.NewAttribute(types.AttributeKeyTokenID, p.TokenId),
+			sdk.NewAttribute(types.AttributeKeyHidden, fmt.Sprintf("%t", p.Hidden)),
+		),
+	)
+	return nil
+}
+
+func handleReassignDenomCreatorProposal(ctx sdk.Context, k keeper.Keeper, p *types.ReassignDenomCreatorProposal) error {
+	creator, err := sdk.AccAddressFromBech32(p.Creator)
+	if err != nil {
+		return err
+	}
+	denom, err := k.GetDenom(ctx, p.DenomId)
+	if err != nil {
+		return err
+	}
+	if err := k.ReassignDenomCreator(ctx, p.DenomId, creator); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReassignDenomCreator,
+			sdk.NewAttribute(types.AttributeKeyDenom, p.DenomId),
+			sdk.NewAttribute(types.AttributeKeyCreator, p.Creator),
+		),
+	)
+	return types.EmitTypedEvent(ctx, &types.EventReassignDenomCreator{
+		DenomId:         p.DenomId,
+		Creator:         p.Creator,
+		PreviousCreator: denom.Creator.String(),
+	})
+}
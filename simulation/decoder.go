@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	"github.com/irismod/nft/types"
@@ -23,7 +24,8 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 			idA := types.MustUnMarshalTokenID(cdc, kvA.Value)
 			idB := types.MustUnMarshalTokenID(cdc, kvB.Value)
 			return fmt.Sprintf("%v\n%v", idA, idB)
-		case bytes.Equal(kvA.Key[:1], types.PrefixCollection):
+		case bytes.Equal(kvA.Key[:1], types.PrefixCollection),
+			bytes.Equal(kvA.Key[:1], types.PrefixVersion):
 			supplyA := types.MustUnMarshalSupply(cdc, kvA.Value)
 			supplyB := types.MustUnMarshalSupply(cdc, kvB.Value)
 			return fmt.Sprintf("%d\n%d", supplyA, supplyB)
@@ -32,6 +34,17 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshalBinaryBare(kvA.Value, &denomA)
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &denomB)
 			return fmt.Sprintf("%v\n%v", denomA, denomB)
+		case bytes.Equal(kvA.Key[:1], types.PrefixDeposit):
+			var depositA, depositB sdk.Coin
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &depositA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &depositB)
+			return fmt.Sprintf("%v\n%v", depositA, depositB)
+		case bytes.Equal(kvA.Key[:1], types.PrefixPolicy):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+		case bytes.Equal(kvA.Key[:1], types.PrefixHidden),
+			bytes.Equal(kvA.Key[:1], types.PrefixPaused),
+			bytes.Equal(kvA.Key[:1], types.PrefixPolicyList):
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
 
 		default:
 			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
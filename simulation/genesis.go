@@ -3,7 +3,9 @@ package simulation
 import (
 	"encoding/json"
 	"fmt"
+	"math/rand"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 
@@ -38,7 +40,9 @@ func RandomizedGenState(simState *module.SimulationState) {
 				simtypes.RandStringOfLength(simState.Rand, 10),
 				acc.Address,
 				simtypes.RandStringOfLength(simState.Rand, 45), // tokenURI
+				"", // tokenURIHash
 				simtypes.RandStringOfLength(simState.Rand, 10),
+				nil, // attributes
 			)
 
 			// 50% doggos and 50% kitties
@@ -52,7 +56,14 @@ func RandomizedGenState(simState *module.SimulationState) {
 		}
 	}
 
-	nftGenesis := types.NewGenesisState(collections)
+	var depositPerByte int64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, string(types.KeyDepositPerByte), &depositPerByte, simState.Rand,
+		func(r *rand.Rand) { depositPerByte = r.Int63n(3) },
+	)
+	params := types.NewParams(sdk.NewInt64Coin(sdk.DefaultBondDenom, depositPerByte), false)
+
+	nftGenesis := types.NewGenesisState(params, collections, nil, nil, nil, nil, nil, nil, nil)
 
 	bz, err := json.MarshalIndent(nftGenesis, "", " ")
 	if err != nil {
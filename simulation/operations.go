@@ -91,15 +91,16 @@ func SimulateMsgTransferNFT(k keeper.Keeper, ak types.AccountKeeper, bk types.Ba
 			denom,
 			"",
 			"",
+			"",
 			simtypes.RandStringOfLength(r, 10), // tokenData
-			ownerAddr,                          // sender
-			recipientAccount.Address,           // recipient
+			ownerAddr.String(),                 // sender
+			recipientAccount.Address.String(),  // recipient
 		)
-		account := ak.GetAccount(ctx, msg.Sender)
+		account := ak.GetAccount(ctx, ownerAddr)
 
-		ownerAccount, found := simtypes.FindAccount(accs, msg.Sender)
+		ownerAccount, found := simtypes.FindAccount(accs, ownerAddr)
 		if !found {
-			err = fmt.Errorf("account %s not found", msg.Sender)
+			err = fmt.Errorf("account %s not found", ownerAddr)
 			return simtypes.NoOpMsg(types.ModuleName, types.EventTypeTransfer, err.Error()), nil, err
 		}
 
@@ -147,18 +148,20 @@ func SimulateMsgEditNFT(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKe
 			denom,
 			"",
 			simtypes.RandStringOfLength(r, 45), // tokenURI
+			"",                                 // tokenURIHash
 			simtypes.RandStringOfLength(r, 10), // tokenData
-			ownerAddr,
+			randAttributes(r),                  // attributes
+			ownerAddr.String(),
 		)
 
-		account := ak.GetAccount(ctx, msg.Sender)
+		account := ak.GetAccount(ctx, ownerAddr)
 		spendable := bk.SpendableCoins(ctx, account.GetAddress())
 		fees, err := simtypes.RandomFees(r, ctx, spendable)
 		if err != nil {
 			return simtypes.NoOpMsg(types.ModuleName, types.EventTypeEditNFT, err.Error()), nil, err
 		}
 
-		ownerAccount, found := simtypes.FindAccount(accs, msg.Sender)
+		ownerAccount, found := simtypes.FindAccount(accs, ownerAddr)
 		if !found {
 			err = fmt.Errorf("account %s not found", ownerAddr)
 			return simtypes.NoOpMsg(types.ModuleName, types.EventTypeEditNFT, err.Error()), nil, err
@@ -200,21 +203,23 @@ func SimulateMsgMintNFT(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKe
 			getRandomDenom(ctx, k, r),         // denom
 			"",
 			simtypes.RandStringOfLength(r, 45), // tokenURI
+			"",                                 // tokenURIHash
 			simtypes.RandStringOfLength(r, 10), // tokenData
-			randomSender.Address,               // sender
-			randomRecipient.Address,            // recipient
+			randAttributes(r),                  // attributes
+			randomSender.Address.String(),      // sender
+			randomRecipient.Address.String(),   // recipient
 		)
 
-		account := ak.GetAccount(ctx, msg.Sender)
+		account := ak.GetAccount(ctx, randomSender.Address)
 		spendable := bk.SpendableCoins(ctx, account.GetAddress())
 		fees, err := simtypes.RandomFees(r, ctx, spendable)
 		if err != nil {
 			return simtypes.NoOpMsg(types.ModuleName, types.EventTypeMintNFT, err.Error()), nil, err
 		}
 
-		simAccount, found := simtypes.FindAccount(accs, msg.Sender)
+		simAccount, found := simtypes.FindAccount(accs, randomSender.Address)
 		if !found {
-			err = fmt.Errorf("account %s not found", msg.Sender)
+			err = fmt.Errorf("account %s not found", randomSender.Address)
 			return simtypes.NoOpMsg(types.ModuleName, types.EventTypeMintNFT, err.Error()), nil, err
 		}
 
@@ -251,18 +256,18 @@ func SimulateMsgBurnNFT(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKe
 			return simtypes.NoOpMsg(types.ModuleName, types.EventTypeBurnNFT, err.Error()), nil, err
 		}
 
-		msg := types.NewMsgBurnNFT(ownerAddr, nftID, denom)
+		msg := types.NewMsgBurnNFT(ownerAddr.String(), nftID, denom)
 
-		account := ak.GetAccount(ctx, msg.Sender)
+		account := ak.GetAccount(ctx, ownerAddr)
 		spendable := bk.SpendableCoins(ctx, account.GetAddress())
 		fees, err := simtypes.RandomFees(r, ctx, spendable)
 		if err != nil {
 			return simtypes.NoOpMsg(types.ModuleName, types.EventTypeBurnNFT, err.Error()), nil, err
 		}
 
-		simAccount, found := simtypes.FindAccount(accs, msg.Sender)
+		simAccount, found := simtypes.FindAccount(accs, ownerAddr)
 		if !found {
-			err = fmt.Errorf("account %s not found", msg.Sender)
+			err = fmt.Errorf("account %s not found", ownerAddr)
 			return simtypes.NoOpMsg(types.ModuleName, types.EventTypeBurnNFT, err.Error()), nil, err
 		}
 
@@ -289,38 +294,18 @@ func SimulateMsgBurnNFT(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKe
 	}
 }
 
+// getRandomNFTFromOwner picks a NFT of the owner index uniformly, sampling the
+// index as it is iterated instead of loading it
 func getRandomNFTFromOwner(ctx sdk.Context, k keeper.Keeper, r *rand.Rand) (address sdk.AccAddress, denom, nftID string) {
-	owners := k.GetOwners(ctx)
-
-	ownersLen := len(owners)
-	if ownersLen == 0 {
-		return nil, "", ""
-	}
-
-	// get random owner
-	i := r.Intn(ownersLen)
-	owner := owners[i]
-
-	idCollectionsLen := len(owner.IDCollections)
-	if idCollectionsLen == 0 {
-		return nil, "", ""
-	}
-
-	// get random collection from owner's balance
-	i = r.Intn(idCollectionsLen)
-	idsCollection := owner.IDCollections[i] // nfts IDs
-	denom = idsCollection.Denom
-
-	idsLen := len(idsCollection.Ids)
-	if idsLen == 0 {
-		return nil, "", ""
-	}
-
-	// get random nft from collection
-	i = r.Intn(idsLen)
-	nftID = idsCollection.Ids[i]
-
-	return owner.Address, denom, nftID
+	seen := 0
+	k.IterateOwners(ctx, func(owner sdk.AccAddress, denomID, tokenID string) bool {
+		seen++
+		if r.Intn(seen) == 0 {
+			address, denom, nftID = owner, denomID, tokenID
+		}
+		return false
+	})
+	return address, denom, nftID
 }
 
 func getRandomDenom(ctx sdk.Context, k keeper.Keeper, r *rand.Rand) string {
@@ -328,3 +313,19 @@ func getRandomDenom(ctx sdk.Context, k keeper.Keeper, r *rand.Rand) string {
 	i := r.Intn(len(denoms))
 	return denoms[i]
 }
+
+// randAttributes returns up to 3 attributes drawn from a small set of traits
+// so that the trait index holds several NFTs per value
+func randAttributes(r *rand.Rand) []types.Attribute {
+	keys := []string{"rarity", "color", "level"}
+	values := []string{"1", "2", "3"}
+
+	var attributes []types.Attribute
+	for _, key := range keys {
+		if r.Intn(2) == 0 {
+			continue
+		}
+		attributes = append(attributes, types.NewAttribute(key, values[r.Intn(len(values))], ""))
+	}
+	return attributes
+}
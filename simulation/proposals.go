@@ -0,0 +1,97 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/irismod/nft/keeper"
+	"github.com/irismod/nft/types"
+)
+
+// Simulation proposal weights constants
+const (
+	OpWeightSubmitForceBurnProposal            = "op_weight_submit_force_burn_proposal"
+	OpWeightSubmitHideProposal                 = "op_weight_submit_hide_proposal"
+	OpWeightSubmitReassignDenomCreatorProposal = "op_weight_submit_reassign_denom_creator_proposal"
+)
+
+// ProposalContents defines the module weighted proposals' contents
+func ProposalContents(k keeper.Keeper) []simtypes.WeightedProposalContent {
+	return []simtypes.WeightedProposalContent{
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitForceBurnProposal,
+			5,
+			SimulateForceBurnProposalContent(k),
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitHideProposal,
+			5,
+			SimulateHideProposalContent(k),
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitReassignDenomCreatorProposal,
+			5,
+			SimulateReassignDenomCreatorProposalContent(k),
+		),
+	}
+}
+
+// SimulateForceBurnProposalContent generates random force-burn proposal content
+func SimulateForceBurnProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) simtypes.Content {
+		_, denom, nftID := getRandomNFTFromOwner(ctx, k, r)
+		if len(nftID) == 0 {
+			return nil
+		}
+
+		return types.NewForceBurnProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			denom,
+			nftID,
+		)
+	}
+}
+
+// SimulateHideProposalContent generates random hide proposal content, hiding
+// either a NFT or its whole denom
+func SimulateHideProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) simtypes.Content {
+		_, denom, nftID := getRandomNFTFromOwner(ctx, k, r)
+		if len(nftID) == 0 {
+			return nil
+		}
+		if r.Intn(2) == 0 {
+			nftID = ""
+		}
+
+		return types.NewHideProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			denom,
+			nftID,
+			r.Intn(4) != 0,
+		)
+	}
+}
+
+// SimulateReassignDenomCreatorProposalContent generates random reassign-denom-creator proposal content
+func SimulateReassignDenomCreatorProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
+		denom := getRandomDenom(ctx, k, r)
+		if !k.HasDenomID(ctx, denom) {
+			return nil
+		}
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		return types.NewReassignDenomCreatorProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			denom,
+			simAccount.Address.String(),
+		)
+	}
+}
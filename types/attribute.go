@@ -0,0 +1,104 @@
+package types
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// supported attribute value types, an empty type is treated as a string
+const (
+	AttributeTypeString  = "string"
+	AttributeTypeNumber  = "number"
+	AttributeTypeBoolean = "boolean"
+
+	MaxAttributes        = 32
+	MaxAttributeKeyLen   = 64
+	MaxAttributeValueLen = 256
+)
+
+var (
+	// IsAttributeKey only accepts alphanumeric characters, '_', '-' and '.'
+	IsAttributeKey = regexp.MustCompile(`^[a-zA-Z0-9_.\-]+$`).MatchString
+)
+
+// NewAttribute return a new attribute
+func NewAttribute(key, value, attrType string) Attribute {
+	return Attribute{
+		Key:   strings.TrimSpace(key),
+		Value: strings.TrimSpace(value),
+		Type:  strings.ToLower(strings.TrimSpace(attrType)),
+	}
+}
+
+// DoNotModifyAttributes returns the attributes used to indicate that the
+// attributes of a NFT should not be updated
+func DoNotModifyAttributes() []Attribute {
+	return []Attribute{{Key: DoNotModify}}
+}
+
+// IsDoNotModifyAttributes returns true if the attributes should not be updated,
+// either empty as left out by the clients or set to DoNotModifyAttributes
+func IsDoNotModifyAttributes(attributes []Attribute) bool {
+	return len(attributes) == 0 || (len(attributes) == 1 && attributes[0].Key == DoNotModify)
+}
+
+// ValidateAttributes checks the attributes of a NFT. Keys and values are
+// used to build the trait index, so neither of them may contain a '/'
+func ValidateAttributes(attributes []Attribute) error {
+	if IsDoNotModifyAttributes(attributes) {
+		return nil
+	}
+
+	if len(attributes) > MaxAttributes {
+		return sdkerrors.Wrapf(ErrInvalidAttribute, "too many attributes, only accepts value [0, %d]", MaxAttributes)
+	}
+
+	seenKeys := make(map[string]bool, len(attributes))
+	for _, attr := range attributes {
+		if err := ValidateAttribute(attr); err != nil {
+			return err
+		}
+		if seenKeys[attr.Key] {
+			return sdkerrors.Wrapf(ErrInvalidAttribute, "duplicate attribute key %s", attr.Key)
+		}
+		seenKeys[attr.Key] = true
+	}
+	return nil
+}
+
+// ValidateAttribute checks the key, value and type of a single attribute
+func ValidateAttribute(attr Attribute) error {
+	if len(attr.Key) == 0 || len(attr.Key) > MaxAttributeKeyLen {
+		return sdkerrors.Wrapf(ErrInvalidAttribute, "invalid attribute key %s, only accepts length [1, %d]", attr.Key, MaxAttributeKeyLen)
+	}
+	if !IsAttributeKey(attr.Key) {
+		return sdkerrors.Wrapf(ErrInvalidAttribute, "invalid attribute key %s, only accepts alphanumeric characters, '_', '-' and '.'", attr.Key)
+	}
+
+	if len(attr.Value) == 0 || len(attr.Value) > MaxAttributeValueLen {
+		return sdkerrors.Wrapf(ErrInvalidAttribute, "invalid value of attribute %s, only accepts length [1, %d]", attr.Key, MaxAttributeValueLen)
+	}
+	if !utf8.ValidString(attr.Value) || strings.Contains(attr.Value, string(delimiter)) {
+		return sdkerrors.Wrapf(ErrInvalidAttribute, "invalid value of attribute %s, must be utf8 without '/'", attr.Key)
+	}
+
+	switch attr.Type {
+	case "", AttributeTypeString:
+	case AttributeTypeNumber:
+		if _, err := strconv.ParseFloat(attr.Value, 64); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidAttribute, "value %s of attribute %s is not a number", attr.Value, attr.Key)
+		}
+	case AttributeTypeBoolean:
+		if _, err := strconv.ParseBool(attr.Value); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidAttribute, "value %s of attribute %s is not a boolean", attr.Value, attr.Key)
+		}
+	default:
+		return sdkerrors.Wrapf(ErrInvalidAttribute, "invalid type %s of attribute %s, only accepts %s, %s or %s",
+			attr.Type, attr.Key, AttributeTypeString, AttributeTypeNumber, AttributeTypeBoolean)
+	}
+	return nil
+}
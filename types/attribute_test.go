@@ -0,0 +1,51 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/irismod/nft/types"
+)
+
+func TestValidateAttributes(t *testing.T) {
+	tooMany := make([]types.Attribute, types.MaxAttributes+1)
+	for i := range tooMany {
+		tooMany[i] = types.NewAttribute(strings.Repeat("k", i+1), "v", "")
+	}
+
+	tests := []struct {
+		name       string
+		attributes []types.Attribute
+		wantErr    bool
+	}{
+		{"empty", nil, false},
+		{"do not modify", types.DoNotModifyAttributes(), false},
+		{"string", []types.Attribute{types.NewAttribute("rarity", "legendary", "")}, false},
+		{"number", []types.Attribute{types.NewAttribute("level", "3.5", types.AttributeTypeNumber)}, false},
+		{"boolean", []types.Attribute{types.NewAttribute("shiny", "true", types.AttributeTypeBoolean)}, false},
+		{"empty key", []types.Attribute{types.NewAttribute("", "legendary", "")}, true},
+		{"invalid key", []types.Attribute{types.NewAttribute("rar/ity", "legendary", "")}, true},
+		{"empty value", []types.Attribute{types.NewAttribute("rarity", "", "")}, true},
+		{"value with delimiter", []types.Attribute{types.NewAttribute("edition", "1/10", "")}, true},
+		{"value too long", []types.Attribute{types.NewAttribute("rarity", strings.Repeat("a", types.MaxAttributeValueLen+1), "")}, true},
+		{"not a number", []types.Attribute{types.NewAttribute("level", "high", types.AttributeTypeNumber)}, true},
+		{"not a boolean", []types.Attribute{types.NewAttribute("shiny", "maybe", types.AttributeTypeBoolean)}, true},
+		{"unknown type", []types.Attribute{types.NewAttribute("rarity", "legendary", "date")}, true},
+		{"duplicate key", []types.Attribute{
+			types.NewAttribute("rarity", "legendary", ""),
+			types.NewAttribute("rarity", "common", ""),
+		}, true},
+		{"too many", tooMany, true},
+	}
+
+	for _, tt := range tests {
+		err := types.ValidateAttributes(tt.attributes)
+		if tt.wantErr {
+			require.Error(t, err, tt.name)
+		} else {
+			require.NoError(t, err, tt.name)
+		}
+	}
+}
@@ -0,0 +1,300 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Authorization defines a grant allowing a grantee to execute a NFT message on
+// behalf of the granter, it follows the authz Authorization contract. The
+// grants are stored by the module and executed with MsgExecAuthorized.
+type Authorization interface {
+	proto.Message
+
+	// MsgTypeURL returns the type URL of the message the authorization applies to
+	MsgTypeURL() string
+
+	// Accept determines whether the grant permits the execution of the message,
+	// and returns the updated authorization when its quota changed
+	Accept(ctx sdk.Context, msg sdk.Msg) (AcceptResponse, error)
+
+	// ValidateBasic does a simple validation check that doesn't require access
+	// to any other information
+	ValidateBasic() error
+}
+
+// AcceptResponse instruments the caller of Authorization.Accept
+type AcceptResponse struct {
+	// Accept is true if the message may be executed
+	Accept bool
+	// Delete is true if the authorization is exhausted and must be removed
+	Delete bool
+	// Updated replaces the stored authorization when it is not nil
+	Updated Authorization
+}
+
+var (
+	_ codectypes.UnpackInterfacesMessage = Grant{}
+	_ codectypes.UnpackInterfacesMessage = GrantAuthorization{}
+
+	_ Authorization = &TransferAuthorization{}
+	_ Authorization = &MintAuthorization{}
+	_ Authorization = &EditAuthorization{}
+	_ Authorization = &BurnAuthorization{}
+)
+
+// NewGrant creates a Grant of the authorization until the expiration
+func NewGrant(authorization Authorization, expiration time.Time) (Grant, error) {
+	any, err := codectypes.NewAnyWithValue(authorization)
+	if err != nil {
+		return Grant{}, err
+	}
+	return Grant{Authorization: any, Expiration: expiration}, nil
+}
+
+// GetAuthorization returns the authorization of the grant, nil if it can't be
+// unpacked
+func (g Grant) GetAuthorization() Authorization {
+	return unpackAuthorization(g.Authorization)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g Grant) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var authorization Authorization
+	return unpacker.UnpackAny(g.Authorization, &authorization)
+}
+
+// NewGrantAuthorization creates a GrantAuthorization of the genesis state
+func NewGrantAuthorization(granter, grantee sdk.AccAddress, grant Grant) GrantAuthorization {
+	return GrantAuthorization{
+		Granter:       granter.String(),
+		Grantee:       grantee.String(),
+		Authorization: grant.Authorization,
+		Expiration:    grant.Expiration,
+	}
+}
+
+// GetAuthorization returns the authorization of the grant, nil if it can't be
+// unpacked
+func (g GrantAuthorization) GetAuthorization() Authorization {
+	return unpackAuthorization(g.Authorization)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g GrantAuthorization) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var authorization Authorization
+	return unpacker.UnpackAny(g.Authorization, &authorization)
+}
+
+func unpackAuthorization(any *codectypes.Any) Authorization {
+	if any == nil {
+		return nil
+	}
+	authorization, _ := any.GetCachedValue().(Authorization)
+	return authorization
+}
+
+// NewTransferAuthorization creates a TransferAuthorization
+func NewTransferAuthorization(denomID string, tokenIDs []string) *TransferAuthorization {
+	return &TransferAuthorization{
+		DenomId:  normalizeID(denomID),
+		TokenIds: normalizeIDs(tokenIDs),
+	}
+}
+
+// MsgTypeURL implements Authorization
+func (a TransferAuthorization) MsgTypeURL() string { return MsgTypeURL(&MsgTransferNFT{}) }
+
+// Accept implements Authorization
+func (a TransferAuthorization) Accept(_ sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
+	transfer, ok := msg.(*MsgTransferNFT)
+	if !ok {
+		return AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type mismatch, expected %s", a.MsgTypeURL())
+	}
+	if err := acceptToken(a.DenomId, a.TokenIds, transfer.Denom, transfer.Id); err != nil {
+		return AcceptResponse{}, err
+	}
+	return AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements Authorization
+func (a TransferAuthorization) ValidateBasic() error {
+	return validateTokenGrant(a.DenomId, a.TokenIds)
+}
+
+// NewMintAuthorization creates a MintAuthorization
+func NewMintAuthorization(denomID string, spendLimit uint64) *MintAuthorization {
+	return &MintAuthorization{
+		DenomId:    normalizeID(denomID),
+		SpendLimit: spendLimit,
+	}
+}
+
+// MsgTypeURL implements Authorization
+func (a MintAuthorization) MsgTypeURL() string { return MsgTypeURL(&MsgMintNFT{}) }
+
+// Accept implements Authorization, every mint decrements the spend limit and
+// the authorization is deleted once it is exhausted
+func (a MintAuthorization) Accept(_ sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
+	mint, ok := msg.(*MsgMintNFT)
+	if !ok {
+		return AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type mismatch, expected %s", a.MsgTypeURL())
+	}
+	if normalizeID(mint.Denom) != a.DenomId {
+		return AcceptResponse{}, sdkerrors.Wrapf(ErrUnauthorized, "denom %s is not granted", mint.Denom)
+	}
+	if a.SpendLimit == 0 {
+		return AcceptResponse{}, sdkerrors.Wrap(ErrUnauthorized, "mint spend limit exhausted")
+	}
+
+	spendLimit := a.SpendLimit - 1
+	if spendLimit == 0 {
+		return AcceptResponse{Accept: true, Delete: true}, nil
+	}
+	return AcceptResponse{Accept: true, Updated: NewMintAuthorization(a.DenomId, spendLimit)}, nil
+}
+
+// ValidateBasic implements Authorization
+func (a MintAuthorization) ValidateBasic() error {
+	if err := ValidateDenomID(a.DenomId); err != nil {
+		return err
+	}
+	if a.SpendLimit == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "spend limit must be positive")
+	}
+	return nil
+}
+
+// NewEditAuthorization creates an EditAuthorization
+func NewEditAuthorization(denomID string, tokenIDs []string) *EditAuthorization {
+	return &EditAuthorization{
+		DenomId:  normalizeID(denomID),
+		TokenIds: normalizeIDs(tokenIDs),
+	}
+}
+
+// MsgTypeURL implements Authorization
+func (a EditAuthorization) MsgTypeURL() string { return MsgTypeURL(&MsgEditNFT{}) }
+
+// Accept implements Authorization
+func (a EditAuthorization) Accept(_ sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
+	edit, ok := msg.(*MsgEditNFT)
+	if !ok {
+		return AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type mismatch, expected %s", a.MsgTypeURL())
+	}
+	if err := acceptToken(a.DenomId, a.TokenIds, edit.Denom, edit.Id); err != nil {
+		return AcceptResponse{}, err
+	}
+	return AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements Authorization
+func (a EditAuthorization) ValidateBasic() error {
+	return validateTokenGrant(a.DenomId, a.TokenIds)
+}
+
+// NewBurnAuthorization creates a BurnAuthorization
+func NewBurnAuthorization(denomID string, tokenIDs []string) *BurnAuthorization {
+	return &BurnAuthorization{
+		DenomId:  normalizeID(denomID),
+		TokenIds: normalizeIDs(tokenIDs),
+	}
+}
+
+// MsgTypeURL implements Authorization
+func (a BurnAuthorization) MsgTypeURL() string { return MsgTypeURL(&MsgBurnNFT{}) }
+
+// Accept implements Authorization, a burnt token is removed from the granted
+// tokens and the authorization is deleted once none is left
+func (a BurnAuthorization) Accept(_ sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
+	burn, ok := msg.(*MsgBurnNFT)
+	if !ok {
+		return AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type mismatch, expected %s", a.MsgTypeURL())
+	}
+	if err := acceptToken(a.DenomId, a.TokenIds, burn.Denom, burn.Id); err != nil {
+		return AcceptResponse{}, err
+	}
+	if len(a.TokenIds) == 0 {
+		return AcceptResponse{Accept: true}, nil
+	}
+
+	tokenID := normalizeID(burn.Id)
+	var tokenIDs []string
+	for _, id := range a.TokenIds {
+		if id != tokenID {
+			tokenIDs = append(tokenIDs, id)
+		}
+	}
+	if len(tokenIDs) == 0 {
+		return AcceptResponse{Accept: true, Delete: true}, nil
+	}
+	return AcceptResponse{Accept: true, Updated: NewBurnAuthorization(a.DenomId, tokenIDs)}, nil
+}
+
+// ValidateBasic implements Authorization
+func (a BurnAuthorization) ValidateBasic() error {
+	return validateTokenGrant(a.DenomId, a.TokenIds)
+}
+
+// MsgTypeURL returns the type URL of the message, the key of its grants
+func MsgTypeURL(msg proto.Message) string {
+	return "/" + proto.MessageName(msg)
+}
+
+func normalizeID(id string) string {
+	return strings.ToLower(strings.TrimSpace(id))
+}
+
+func normalizeIDs(ids []string) []string {
+	if len(ids) == 0 {
+		return nil
+	}
+	normalized := make([]string, len(ids))
+	for i, id := range ids {
+		normalized[i] = normalizeID(id)
+	}
+	return normalized
+}
+
+// acceptToken checks that the token belongs to the granted denom and, when the
+// grant is restricted to a list of tokens, that it is one of them
+func acceptToken(grantedDenomID string, grantedTokenIDs []string, denomID, tokenID string) error {
+	if normalizeID(denomID) != grantedDenomID {
+		return sdkerrors.Wrapf(ErrUnauthorized, "denom %s is not granted", denomID)
+	}
+	if len(grantedTokenIDs) == 0 {
+		return nil
+	}
+
+	tokenID = normalizeID(tokenID)
+	for _, id := range grantedTokenIDs {
+		if id == tokenID {
+			return nil
+		}
+	}
+	return sdkerrors.Wrapf(ErrUnauthorized, "token %s is not granted", tokenID)
+}
+
+func validateTokenGrant(denomID string, tokenIDs []string) error {
+	if err := ValidateDenomID(denomID); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(tokenIDs))
+	for _, tokenID := range tokenIDs {
+		if err := ValidateTokenID(tokenID); err != nil {
+			return err
+		}
+		if seen[tokenID] {
+			return sdkerrors.Wrapf(ErrInvalidTokenID, "duplicate tokenID %s", tokenID)
+		}
+		seen[tokenID] = true
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irismod/nft/types"
+)
+
+func TestTransferAuthorizationAccept(t *testing.T) {
+	ctx := sdk.Context{}
+	auth := types.NewTransferAuthorization(denom, []string{id})
+	require.NoError(t, auth.ValidateBasic())
+	require.Equal(t, "/irismod.nft.MsgTransferNFT", auth.MsgTypeURL())
+
+	res, err := auth.Accept(ctx, types.NewMsgTransferNFT(id, denom, nftName, tokenURI, tokenURIHash, tokenData, address.String(), address2.String()))
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+	require.Nil(t, res.Updated)
+
+	_, err = auth.Accept(ctx, types.NewMsgTransferNFT("id2", denom, nftName, tokenURI, tokenURIHash, tokenData, address.String(), address2.String()))
+	require.Error(t, err)
+
+	_, err = auth.Accept(ctx, types.NewMsgTransferNFT(id, "denom2", nftName, tokenURI, tokenURIHash, tokenData, address.String(), address2.String()))
+	require.Error(t, err)
+
+	_, err = auth.Accept(ctx, types.NewMsgBurnNFT(address.String(), id, denom))
+	require.Error(t, err)
+
+	res, err = types.NewTransferAuthorization(denom, nil).Accept(ctx, types.NewMsgTransferNFT("id2", denom, nftName, tokenURI, tokenURIHash, tokenData, address.String(), address2.String()))
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+}
+
+func TestMintAuthorizationAccept(t *testing.T) {
+	ctx := sdk.Context{}
+	msg := types.NewMsgMintNFT(id, denom, nftName, tokenURI, tokenURIHash, tokenData, attributes, address.String(), address2.String())
+
+	auth := types.NewMintAuthorization(denom, 2)
+	require.NoError(t, auth.ValidateBasic())
+	require.Error(t, types.NewMintAuthorization(denom, 0).ValidateBasic())
+
+	res, err := auth.Accept(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+	require.Equal(t, types.NewMintAuthorization(denom, 1), res.Updated)
+
+	res, err = res.Updated.Accept(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.True(t, res.Delete)
+
+	_, err = auth.Accept(ctx, types.NewMsgMintNFT(id, "denom2", nftName, tokenURI, tokenURIHash, tokenData, attributes, address.String(), address2.String()))
+	require.Error(t, err)
+}
+
+func TestEditAuthorizationAccept(t *testing.T) {
+	ctx := sdk.Context{}
+	auth := types.NewEditAuthorization(denom, []string{id})
+	require.NoError(t, auth.ValidateBasic())
+
+	res, err := auth.Accept(ctx, types.NewMsgEditNFT(id, denom, nftName, tokenURI, tokenURIHash, tokenData, attributes, address.String()))
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+
+	_, err = auth.Accept(ctx, types.NewMsgEditNFT("id2", denom, nftName, tokenURI, tokenURIHash, tokenData, attributes, address.String()))
+	require.Error(t, err)
+}
+
+func TestBurnAuthorizationAccept(t *testing.T) {
+	ctx := sdk.Context{}
+	auth := types.NewBurnAuthorization(denom, []string{id, "id2"})
+	require.NoError(t, auth.ValidateBasic())
+
+	res, err := auth.Accept(ctx, types.NewMsgBurnNFT(address.String(), id, denom))
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+	require.Equal(t, types.NewBurnAuthorization(denom, []string{"id2"}), res.Updated)
+
+	res, err = res.Updated.Accept(ctx, types.NewMsgBurnNFT(address.String(), "id2", denom))
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.True(t, res.Delete)
+
+	_, err = types.NewBurnAuthorization(denom, []string{"id2"}).Accept(ctx, types.NewMsgBurnNFT(address.String(), id, denom))
+	require.Error(t, err)
+}
+
+func TestValidateAuthorizationTokenIDs(t *testing.T) {
+	require.Error(t, types.NewTransferAuthorization(denom, []string{id, id}).ValidateBasic())
+	require.Error(t, types.NewTransferAuthorization(denom, []string{"1d"}).ValidateBasic())
+	require.Error(t, types.NewTransferAuthorization("", nil).ValidateBasic())
+}
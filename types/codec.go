@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	gogotypes "github.com/gogo/protobuf/types"
 
 	"github.com/irismod/nft/exported"
@@ -19,9 +20,28 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgEditNFT{}, "irismod/nft/MsgEditNFT", nil)
 	cdc.RegisterConcrete(&MsgMintNFT{}, "irismod/nft/MsgMintNFT", nil)
 	cdc.RegisterConcrete(&MsgBurnNFT{}, "irismod/nft/MsgBurnNFT", nil)
+	cdc.RegisterConcrete(&MsgRevokeNFT{}, "irismod/nft/MsgRevokeNFT", nil)
+	cdc.RegisterConcrete(&MsgPauseDenom{}, "irismod/nft/MsgPauseDenom", nil)
+	cdc.RegisterConcrete(&MsgUnpauseDenom{}, "irismod/nft/MsgUnpauseDenom", nil)
+	cdc.RegisterConcrete(&MsgSetTransferPolicy{}, "irismod/nft/MsgSetTransferPolicy", nil)
+	cdc.RegisterConcrete(&MsgUpdatePolicyList{}, "irismod/nft/MsgUpdatePolicyList", nil)
+	cdc.RegisterConcrete(&MsgSetMetadataTemplate{}, "irismod/nft/MsgSetMetadataTemplate", nil)
+	cdc.RegisterConcrete(&MsgGrantAuthorization{}, "irismod/nft/MsgGrantAuthorization", nil)
+	cdc.RegisterConcrete(&MsgRevokeAuthorization{}, "irismod/nft/MsgRevokeAuthorization", nil)
+	cdc.RegisterConcrete(&MsgExecAuthorized{}, "irismod/nft/MsgExecAuthorized", nil)
 
 	cdc.RegisterInterface((*exported.NFT)(nil), nil)
 	cdc.RegisterConcrete(&BaseNFT{}, "irismod/nft/BaseNFT", nil)
+
+	cdc.RegisterInterface((*Authorization)(nil), nil)
+	cdc.RegisterConcrete(&TransferAuthorization{}, "irismod/nft/TransferAuthorization", nil)
+	cdc.RegisterConcrete(&MintAuthorization{}, "irismod/nft/MintAuthorization", nil)
+	cdc.RegisterConcrete(&EditAuthorization{}, "irismod/nft/EditAuthorization", nil)
+	cdc.RegisterConcrete(&BurnAuthorization{}, "irismod/nft/BurnAuthorization", nil)
+
+	cdc.RegisterConcrete(&ForceBurnProposal{}, "irismod/nft/ForceBurnProposal", nil)
+	cdc.RegisterConcrete(&HideProposal{}, "irismod/nft/HideProposal", nil)
+	cdc.RegisterConcrete(&ReassignDenomCreatorProposal{}, "irismod/nft/ReassignDenomCreatorProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -31,11 +51,35 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgEditNFT{},
 		&MsgMintNFT{},
 		&MsgBurnNFT{},
+		&MsgRevokeNFT{},
+		&MsgPauseDenom{},
+		&MsgUnpauseDenom{},
+		&MsgSetTransferPolicy{},
+		&MsgUpdatePolicyList{},
+		&MsgSetMetadataTemplate{},
+		&MsgGrantAuthorization{},
+		&MsgRevokeAuthorization{},
+		&MsgExecAuthorized{},
 	)
 
 	registry.RegisterImplementations((*exported.NFT)(nil),
 		&BaseNFT{},
 	)
+
+	registry.RegisterInterface(
+		"irismod.nft.Authorization",
+		(*Authorization)(nil),
+		&TransferAuthorization{},
+		&MintAuthorization{},
+		&EditAuthorization{},
+		&BurnAuthorization{},
+	)
+
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&ForceBurnProposal{},
+		&HideProposal{},
+		&ReassignDenomCreatorProposal{},
+	)
 }
 
 var (
@@ -5,18 +5,25 @@ import (
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irismod/nft/types"
 )
 
 // nolint: deadcode unused
 var (
-	denomID   = "denom"
-	denom     = "denom"
-	id        = "id1"
-	nftName   = "report"
-	address   = CreateTestAddrs(1)[0]
-	address2  = CreateTestAddrs(2)[1]
-	tokenURI  = "https://google.com/token-1.json"
+	denomID  = "denom"
+	denom    = "denom"
+	id       = "id1"
+	nftName  = "report"
+	address  = CreateTestAddrs(1)[0]
+	address2 = CreateTestAddrs(2)[1]
+	tokenURI = "https://google.com/token-1.json"
+
+	tokenURIHash = "a591a6d40bf420404a011733cfb7b190d62c65bf0bcda32b57b277d9ad9f146e"
+
 	tokenData = "https://google.com/token-1.json"
+
+	attributes = []types.Attribute{types.NewAttribute("rarity", "legendary", types.AttributeTypeString)}
 )
 
 // CreateTestAddrs creates test addresses
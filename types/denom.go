@@ -8,15 +8,29 @@ import (
 )
 
 // NewDenom return a new denom
-func NewDenom(id, name, schema string, creator sdk.AccAddress) Denom {
+func NewDenom(id, name, schema, uri, uriHash, baseURI string, historyRetention uint64, revocable bool, creator sdk.AccAddress) Denom {
 	return Denom{
-		Id:      id,
-		Name:    name,
-		Schema:  schema,
-		Creator: creator,
+		Id:               id,
+		Name:             name,
+		Schema:           schema,
+		Creator:          creator,
+		URI:              uri,
+		URIHash:          uriHash,
+		BaseURI:          baseURI,
+		HistoryRetention: historyRetention,
+		Revocable:        revocable,
 	}
 }
 
+// GetHistoryRetention returns the number of history entries kept per token,
+// falling back to DefaultHistoryRetention when the denom does not set it
+func (d Denom) GetHistoryRetention() uint64 {
+	if d.HistoryRetention == 0 {
+		return DefaultHistoryRetention
+	}
+	return d.HistoryRetention
+}
+
 func ValidateDenomID(denomID string) error {
 	denomID = strings.TrimSpace(denomID)
 	if len(denomID) < MinDenomLen || len(denomID) > MaxDenomLen {
@@ -27,3 +41,10 @@ func ValidateDenomID(denomID string) error {
 	}
 	return nil
 }
+
+func ValidateHistoryRetention(historyRetention uint64) error {
+	if historyRetention > MaxHistoryRetention {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "invalid history retention %d, only accepts value [0, %d]", historyRetention, MaxHistoryRetention)
+	}
+	return nil
+}
@@ -5,14 +5,24 @@ import (
 )
 
 var (
-	ErrInvalidCollection = sdkerrors.Register(ModuleName, 2, "invalid NFT collection")
-	ErrUnknownCollection = sdkerrors.Register(ModuleName, 3, "unknown NFT collection")
-	ErrInvalidNFT        = sdkerrors.Register(ModuleName, 4, "invalid NFT")
-	ErrNFTAlreadyExists  = sdkerrors.Register(ModuleName, 5, "NFT already exists")
-	ErrUnknownNFT        = sdkerrors.Register(ModuleName, 6, "unknown NFT")
-	ErrEmptyTokenData    = sdkerrors.Register(ModuleName, 7, "NFT tokenData can't be empty")
-	ErrUnauthorized      = sdkerrors.Register(ModuleName, 8, "unauthorized address")
-	ErrInvalidDenom      = sdkerrors.Register(ModuleName, 9, "invalid denom")
-	ErrInvalidTokenID    = sdkerrors.Register(ModuleName, 10, "invalid tokenID")
-	ErrInvalidTokenURI   = sdkerrors.Register(ModuleName, 11, "invalid tokenURI")
+	ErrInvalidCollection       = sdkerrors.Register(ModuleName, 2, "invalid NFT collection")
+	ErrUnknownCollection       = sdkerrors.Register(ModuleName, 3, "unknown NFT collection")
+	ErrInvalidNFT              = sdkerrors.Register(ModuleName, 4, "invalid NFT")
+	ErrNFTAlreadyExists        = sdkerrors.Register(ModuleName, 5, "NFT already exists")
+	ErrUnknownNFT              = sdkerrors.Register(ModuleName, 6, "unknown NFT")
+	ErrEmptyTokenData          = sdkerrors.Register(ModuleName, 7, "NFT tokenData can't be empty")
+	ErrUnauthorized            = sdkerrors.Register(ModuleName, 8, "unauthorized address")
+	ErrInvalidDenom            = sdkerrors.Register(ModuleName, 9, "invalid denom")
+	ErrInvalidTokenID          = sdkerrors.Register(ModuleName, 10, "invalid tokenID")
+	ErrInvalidTokenURI         = sdkerrors.Register(ModuleName, 11, "invalid tokenURI")
+	ErrInvalidURIHash          = sdkerrors.Register(ModuleName, 12, "invalid uriHash")
+	ErrURIHashMismatch         = sdkerrors.Register(ModuleName, 13, "uriHash mismatch")
+	ErrInvalidAttribute        = sdkerrors.Register(ModuleName, 14, "invalid attribute")
+	ErrPaused                  = sdkerrors.Register(ModuleName, 15, "paused")
+	ErrInvalidPolicy           = sdkerrors.Register(ModuleName, 16, "invalid transfer policy")
+	ErrTransferRestricted      = sdkerrors.Register(ModuleName, 17, "transfer restricted by policy")
+	ErrInvalidGenesis          = sdkerrors.Register(ModuleName, 18, "invalid genesis state")
+	ErrMigration               = sdkerrors.Register(ModuleName, 19, "store migration failed")
+	ErrInvalidMetadataTemplate = sdkerrors.Register(ModuleName, 20, "invalid metadata template")
+	ErrInvalidGrant            = sdkerrors.Register(ModuleName, 21, "invalid authorization grant")
 )
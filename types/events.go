@@ -7,6 +7,21 @@ var (
 	EventTypeEditNFT    = "edit_nft"
 	EventTypeMintNFT    = "mint_nft"
 	EventTypeBurnNFT    = "burn_nft"
+	EventTypeRevokeNFT  = "revoke_nft"
+	EventTypePause      = "pause_denom"
+	EventTypeUnpause    = "unpause_denom"
+
+	EventTypeSetTransferPolicy = "set_transfer_policy"
+	EventTypeUpdatePolicyList  = "update_policy_list"
+
+	EventTypeSetMetadataTemplate = "set_metadata_template"
+
+	EventTypeGrantAuthorization  = "grant_authorization"
+	EventTypeRevokeAuthorization = "revoke_authorization"
+
+	EventTypeForceBurnNFT         = "force_burn_nft"
+	EventTypeHide                 = "hide"
+	EventTypeReassignDenomCreator = "reassign_denom_creator"
 
 	AttributeValueCategory = ModuleName
 
@@ -16,4 +31,12 @@ var (
 	AttributeKeyTokenID   = "token-id"
 	AttributeKeyTokenURI  = "token-uri"
 	AttributeKeyDenom     = "denom"
+	AttributeKeyHidden    = "hidden"
+	AttributeKeyCreator   = "creator"
+	AttributeKeyPolicy    = "policy"
+	AttributeKeyList      = "list"
+	AttributeKeyGranter   = "granter"
+	AttributeKeyGrantee   = "grantee"
+
+	AttributeKeyMsgTypeURL = "msg_type_url"
 )
@@ -1,8 +1,307 @@
 package types
 
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(collections []Collection) *GenesisState {
+func NewGenesisState(params Params,
+	collections []Collection,
+	histories []TokenHistory,
+	deposits []TokenDeposit,
+	hidden []Hidden,
+	pausedDenoms []string,
+	policies []DenomTransferPolicy,
+	policyAddresses []PolicyAddress,
+	grants []GrantAuthorization) *GenesisState {
 	return &GenesisState{
-		Collections: collections,
+		Params:          params,
+		Collections:     collections,
+		Histories:       histories,
+		Deposits:        deposits,
+		Hidden:          hidden,
+		PausedDenoms:    pausedDenoms,
+		Policies:        policies,
+		PolicyAddresses: policyAddresses,
+		Grants:          grants,
+	}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (data GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, grant := range data.Grants {
+		if err := grant.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateGenesis validates the genesis state and reports every problem found
+// instead of the first one, each prefixed by the path of the faulty entry. The
+// denom and token ids being lowercased by InitGenesis, they are compared
+// lowercased.
+func ValidateGenesis(data GenesisState) error {
+	var problems []string
+	report := func(err error, format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf("%s: %s", fmt.Sprintf(format, args...), err))
+	}
+
+	if err := data.Params.Validate(); err != nil {
+		report(err, "params")
+	}
+
+	denomIDs := make(map[string]bool, len(data.Collections))
+	denomNames := make(map[string]int, len(data.Collections))
+	tokens := make(map[string]bool)
+	for i, c := range data.Collections {
+		path := fmt.Sprintf("collections[%d] (denom %q)", i, c.Denom.Id)
+
+		if err := ValidateDenomID(c.Denom.Id); err != nil {
+			report(err, path)
+		}
+		if !utf8.ValidString(c.Denom.Name) {
+			report(ErrInvalidDenom, "%s name", path)
+		}
+		if err := ValidateHistoryRetention(c.Denom.HistoryRetention); err != nil {
+			report(err, path)
+		}
+		if err := ValidateURIHash(c.Denom.URIHash); err != nil {
+			report(err, path)
+		}
+		if err := ValidateBaseURI(c.Denom.BaseURI); err != nil {
+			report(err, path)
+		}
+		if err := ValidateMetadataTemplate(c.Denom.MetadataTemplate); err != nil {
+			report(err, path)
+		}
+
+		if denomIDs[strings.ToLower(c.Denom.Id)] {
+			report(ErrInvalidDenom, "%s duplicated denom id", path)
+		}
+		denomIDs[strings.ToLower(c.Denom.Id)] = true
+
+		if len(c.Denom.Name) > 0 {
+			if j, ok := denomNames[c.Denom.Name]; ok {
+				report(ErrInvalidDenom, "%s duplicated denom name %q of collections[%d]", path, c.Denom.Name, j)
+			} else {
+				denomNames[c.Denom.Name] = i
+			}
+		}
+
+		for j, nft := range c.NFTs {
+			nftPath := fmt.Sprintf("%s nfts[%d] (token %q)", path, j, nft.GetID())
+
+			if nft.GetOwner().Empty() {
+				report(sdkerrors.ErrInvalidAddress, "%s missing owner", nftPath)
+			}
+			if err := ValidateTokenID(nft.GetID()); err != nil {
+				report(err, nftPath)
+			}
+			if err := ValidateTokenURI(nft.GetURI()); err != nil {
+				report(err, nftPath)
+			}
+			if err := ValidateURIHash(nft.GetURIHash()); err != nil {
+				report(err, nftPath)
+			}
+			if err := ValidateAttributes(nft.GetAttributes()); err != nil {
+				report(err, nftPath)
+			}
+
+			key := lowerKeyNFT(c.Denom.Id, nft.GetID())
+			if tokens[key] {
+				report(ErrNFTAlreadyExists, "%s duplicated token id", nftPath)
+			}
+			tokens[key] = true
+		}
+	}
+
+	// the other sections reference the collections
+	hasDenom := func(denomID string) bool { return denomIDs[strings.ToLower(denomID)] }
+	hasToken := func(denomID, tokenID string) bool { return tokens[lowerKeyNFT(denomID, tokenID)] }
+
+	histories := make(map[string]bool, len(data.Histories))
+	for i, history := range data.Histories {
+		path := fmt.Sprintf("histories[%d] (denom %q, token %q)", i, history.DenomId, history.TokenId)
+
+		if err := ValidateDenomID(history.DenomId); err != nil {
+			report(err, path)
+		}
+		if err := ValidateTokenID(history.TokenId); err != nil {
+			report(err, path)
+		}
+		if !hasDenom(history.DenomId) {
+			report(ErrUnknownCollection, path)
+		}
+
+		key := lowerKeyNFT(history.DenomId, history.TokenId)
+		if histories[key] {
+			report(ErrInvalidNFT, "%s duplicated history", path)
+		}
+		histories[key] = true
+	}
+
+	deposits := make(map[string]bool, len(data.Deposits))
+	for i, deposit := range data.Deposits {
+		path := fmt.Sprintf("deposits[%d] (denom %q, token %q)", i, deposit.DenomId, deposit.TokenId)
+
+		if err := ValidateDenomID(deposit.DenomId); err != nil {
+			report(err, path)
+		}
+		if err := ValidateTokenID(deposit.TokenId); err != nil {
+			report(err, path)
+		}
+		if !deposit.Amount.IsValid() {
+			report(sdkerrors.ErrInvalidCoins, "%s invalid deposit %s", path, deposit.Amount)
+		}
+		if !hasToken(deposit.DenomId, deposit.TokenId) {
+			report(ErrUnknownNFT, path)
+		}
+
+		key := lowerKeyNFT(deposit.DenomId, deposit.TokenId)
+		if deposits[key] {
+			report(ErrInvalidNFT, "%s duplicated deposit", path)
+		}
+		deposits[key] = true
 	}
+
+	for i, hidden := range data.Hidden {
+		path := fmt.Sprintf("hidden[%d] (denom %q, token %q)", i, hidden.DenomId, hidden.TokenId)
+
+		if err := ValidateDenomID(hidden.DenomId); err != nil {
+			report(err, path)
+		}
+		if len(hidden.TokenId) == 0 {
+			if !hasDenom(hidden.DenomId) {
+				report(ErrUnknownCollection, path)
+			}
+			continue
+		}
+		if err := ValidateTokenID(hidden.TokenId); err != nil {
+			report(err, path)
+		}
+		if !hasToken(hidden.DenomId, hidden.TokenId) {
+			report(ErrUnknownNFT, path)
+		}
+	}
+
+	paused := make(map[string]bool, len(data.PausedDenoms))
+	for i, denomID := range data.PausedDenoms {
+		path := fmt.Sprintf("paused_denoms[%d] (denom %q)", i, denomID)
+
+		if err := ValidateDenomID(denomID); err != nil {
+			report(err, path)
+		}
+		if !hasDenom(denomID) {
+			report(ErrUnknownCollection, path)
+		}
+		if paused[strings.ToLower(denomID)] {
+			report(ErrInvalidDenom, "%s duplicated denom", path)
+		}
+		paused[strings.ToLower(denomID)] = true
+	}
+
+	policies := make(map[string]bool, len(data.Policies))
+	for i, policy := range data.Policies {
+		path := fmt.Sprintf("policies[%d] (denom %q)", i, policy.DenomId)
+
+		if err := ValidateDenomID(policy.DenomId); err != nil {
+			report(err, path)
+		}
+		if len(policy.Policy) == 0 {
+			report(ErrInvalidPolicy, "%s empty policy", path)
+		}
+		if !hasDenom(policy.DenomId) {
+			report(ErrUnknownCollection, path)
+		}
+		if policies[strings.ToLower(policy.DenomId)] {
+			report(ErrInvalidPolicy, "%s duplicated policy", path)
+		}
+		policies[strings.ToLower(policy.DenomId)] = true
+	}
+
+	entries := make(map[string]bool, len(data.PolicyAddresses))
+	for i, entry := range data.PolicyAddresses {
+		path := fmt.Sprintf("policy_addresses[%d] (denom %q, list %q)", i, entry.DenomId, entry.List)
+
+		if err := ValidateDenomID(entry.DenomId); err != nil {
+			report(err, path)
+		}
+		if err := ValidatePolicyList(entry.List); err != nil {
+			report(err, path)
+		}
+		if _, err := sdk.AccAddressFromBech32(entry.Address); err != nil {
+			report(sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid policy address %s (%s)", entry.Address, err), path)
+		}
+		if !hasDenom(entry.DenomId) {
+			report(ErrUnknownCollection, path)
+		}
+
+		key := strings.ToLower(entry.DenomId) + "/" + entry.List + "/" + entry.Address
+		if entries[key] {
+			report(ErrInvalidPolicy, "%s duplicated address %s", path, entry.Address)
+		}
+		entries[key] = true
+	}
+
+	grants := make(map[string]bool, len(data.Grants))
+	for i, grant := range data.Grants {
+		path := fmt.Sprintf("grants[%d] (granter %q, grantee %q)", i, grant.Granter, grant.Grantee)
+
+		if err := validateGrantees(grant.Granter, grant.Grantee); err != nil {
+			report(err, path)
+		}
+		if grant.Expiration.IsZero() {
+			report(ErrInvalidGrant, "%s missing expiration", path)
+		}
+
+		authorization := grant.GetAuthorization()
+		if authorization == nil {
+			report(ErrInvalidGrant, "%s missing authorization", path)
+			continue
+		}
+		if err := authorization.ValidateBasic(); err != nil {
+			report(err, path)
+		}
+
+		key := grant.Granter + "/" + grant.Grantee + "/" + authorization.MsgTypeURL()
+		if grants[key] {
+			report(ErrInvalidGrant, "%s duplicated authorization of %s", path, authorization.MsgTypeURL())
+		}
+		grants[key] = true
+	}
+
+	if len(problems) > 0 {
+		return sdkerrors.Wrapf(ErrInvalidGenesis, "%d problem(s) found:\n%s", len(problems), strings.Join(problems, "\n"))
+	}
+	return nil
+}
+
+// ValidateGenesisPolicies checks that the transfer policies selected by the
+// denoms of the genesis state are registered. The custom policies being
+// registered by the application, ValidateGenesis doesn't check them.
+func ValidateGenesisPolicies(data GenesisState, registered func(name string) bool) error {
+	var problems []string
+	for i, policy := range data.Policies {
+		if len(policy.Policy) > 0 && !registered(policy.Policy) {
+			problems = append(problems, fmt.Sprintf("policies[%d] (denom %q): %s", i, policy.DenomId,
+				sdkerrors.Wrapf(ErrInvalidPolicy, "unknown transfer policy %s", policy.Policy)))
+		}
+	}
+
+	if len(problems) > 0 {
+		return sdkerrors.Wrapf(ErrInvalidGenesis, "%d problem(s) found:\n%s", len(problems), strings.Join(problems, "\n"))
+	}
+	return nil
+}
+
+// lowerKeyNFT returns the store key of the NFT with its ids lowercased
+func lowerKeyNFT(denomID, tokenID string) string {
+	return string(KeyNFT(strings.ToLower(denomID), strings.ToLower(tokenID)))
 }
@@ -25,7 +25,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the nft module's genesis state.
 type GenesisState struct {
-	Collections []Collection `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections"`
+	Collections     []Collection          `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections"`
+	Histories       []TokenHistory        `protobuf:"bytes,2,rep,name=histories,proto3" json:"histories"`
+	Params          Params                `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	Deposits        []TokenDeposit        `protobuf:"bytes,4,rep,name=deposits,proto3" json:"deposits"`
+	Hidden          []Hidden              `protobuf:"bytes,5,rep,name=hidden,proto3" json:"hidden"`
+	PausedDenoms    []string              `protobuf:"bytes,6,rep,name=paused_denoms,json=pausedDenoms,proto3" json:"paused_denoms,omitempty"`
+	Policies        []DenomTransferPolicy `protobuf:"bytes,7,rep,name=policies,proto3" json:"policies"`
+	PolicyAddresses []PolicyAddress       `protobuf:"bytes,8,rep,name=policy_addresses,json=policyAddresses,proto3" json:"policy_addresses"`
+	Grants          []GrantAuthorization  `protobuf:"bytes,9,rep,name=grants,proto3" json:"grants"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,6 +76,62 @@ func (m *GenesisState) GetCollections() []Collection {
 	return nil
 }
 
+func (m *GenesisState) GetHistories() []TokenHistory {
+	if m != nil {
+		return m.Histories
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetDeposits() []TokenDeposit {
+	if m != nil {
+		return m.Deposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHidden() []Hidden {
+	if m != nil {
+		return m.Hidden
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPausedDenoms() []string {
+	if m != nil {
+		return m.PausedDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPolicies() []DenomTransferPolicy {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPolicyAddresses() []PolicyAddress {
+	if m != nil {
+		return m.PolicyAddresses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGrants() []GrantAuthorization {
+	if m != nil {
+		return m.Grants
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "irismod.nft.GenesisState")
 }
@@ -75,19 +139,33 @@ func init() {
 func init() { proto.RegisterFile("genesis.proto", fileDescriptor_14205810582f3203) }
 
 var fileDescriptor_14205810582f3203 = []byte{
-	// 179 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4d, 0x4f, 0xcd, 0x4b,
-	0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0xce, 0x2c, 0xca, 0x2c, 0xce,
-	0xcd, 0x4f, 0xd1, 0xcb, 0x4b, 0x2b, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x8b, 0xeb, 0x83,
-	0x58, 0x10, 0x25, 0x52, 0xdc, 0x25, 0x95, 0x05, 0xa9, 0x50, 0xf5, 0x4a, 0xfe, 0x5c, 0x3c, 0xee,
-	0x10, 0x03, 0x82, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0xec, 0xb9, 0xb8, 0x93, 0xf3, 0x73, 0x72, 0x52,
-	0x93, 0x4b, 0x32, 0xf3, 0xf3, 0x8a, 0x25, 0x18, 0x15, 0x98, 0x35, 0xb8, 0x8d, 0xc4, 0xf5, 0x90,
-	0x4c, 0xd5, 0x73, 0x86, 0xcb, 0x3b, 0xb1, 0x9c, 0xb8, 0x27, 0xcf, 0x10, 0x84, 0xac, 0xc3, 0xc9,
-	0xec, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58,
-	0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x64, 0xd2, 0x33, 0x4b, 0x32,
-	0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0xa1, 0xe6, 0xe9, 0xe7, 0xa5, 0x95, 0xe8, 0x83, 0x9d,
-	0x93, 0xc4, 0x06, 0x76, 0x8f, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xf0, 0x96, 0x94, 0xaa, 0xd0,
-	0x00, 0x00, 0x00,
+	// 407 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x13, 0x53, 0x63, 0x3b, 0xd9, 0x45, 0x19, 0x05, 0xc7, 0x22, 0xd9, 0xa0, 0x97, 0x9e,
+	0x12, 0x5c, 0xc1, 0x8b, 0x88, 0x6c, 0x5d, 0xd8, 0x05, 0x2f, 0xcb, 0xda, 0x93, 0x97, 0x32, 0xcd,
+	0x4c, 0x93, 0xc1, 0x66, 0x26, 0xcc, 0x9b, 0x1c, 0xda, 0x4f, 0xe1, 0xa7, 0xf0, 0xb3, 0xf4, 0xd8,
+	0xa3, 0x27, 0x91, 0xf6, 0x8b, 0x48, 0x26, 0x69, 0x4d, 0x10, 0x6f, 0x33, 0xef, 0xfd, 0x7f, 0xbf,
+	0x07, 0x6f, 0x06, 0x9d, 0x67, 0x5c, 0x72, 0x10, 0x10, 0x97, 0x5a, 0x19, 0x85, 0x03, 0xa1, 0x05,
+	0x14, 0x8a, 0xc5, 0x72, 0x69, 0xc6, 0xcf, 0x32, 0x95, 0x29, 0x5b, 0x4f, 0xea, 0x53, 0x13, 0x19,
+	0x07, 0x66, 0x5d, 0x72, 0x38, 0x5e, 0x68, 0x65, 0xf2, 0x4d, 0x73, 0x79, 0xf5, 0x63, 0x80, 0xce,
+	0x6e, 0x1a, 0xdd, 0x17, 0x43, 0x0d, 0xc7, 0x1f, 0x51, 0x90, 0xaa, 0xd5, 0x8a, 0xa7, 0x46, 0x28,
+	0x09, 0xc4, 0x8d, 0xbc, 0x49, 0x70, 0xf9, 0x3c, 0xee, 0xcc, 0x88, 0x3f, 0x9d, 0xfa, 0xd3, 0xc1,
+	0xf6, 0xd7, 0x85, 0x73, 0xdf, 0x25, 0xf0, 0x07, 0x34, 0xca, 0x05, 0x18, 0xa5, 0x05, 0x07, 0xf2,
+	0xc0, 0xe2, 0x2f, 0x7a, 0xf8, 0x4c, 0x7d, 0xe3, 0xf2, 0xd6, 0x46, 0xd6, 0xad, 0xe0, 0x2f, 0x81,
+	0xdf, 0x20, 0xbf, 0xa4, 0x9a, 0x16, 0x40, 0xbc, 0xc8, 0x9d, 0x04, 0x97, 0x4f, 0x7b, 0xec, 0x9d,
+	0x6d, 0xb5, 0x54, 0x1b, 0xc4, 0xef, 0xd1, 0x90, 0xf1, 0x52, 0x81, 0x30, 0x40, 0x06, 0xff, 0x1b,
+	0x78, 0xdd, 0x24, 0x5a, 0xf4, 0x04, 0xd4, 0xf3, 0x72, 0xc1, 0x18, 0x97, 0xe4, 0x61, 0xe4, 0xfd,
+	0x33, 0xef, 0xd6, 0xb6, 0x8e, 0xf3, 0x9a, 0x20, 0x7e, 0x8d, 0xce, 0x4b, 0x5a, 0x01, 0x67, 0x73,
+	0xc6, 0xa5, 0x2a, 0x80, 0xf8, 0x91, 0x37, 0x19, 0xdd, 0x9f, 0x35, 0xc5, 0x6b, 0x5b, 0xc3, 0x53,
+	0x34, 0x2c, 0xd5, 0x4a, 0xa4, 0xf5, 0x16, 0x1e, 0x59, 0x73, 0xd4, 0x33, 0xdb, 0xd8, 0x4c, 0x53,
+	0x09, 0x4b, 0xae, 0xef, 0xea, 0xe4, 0x71, 0x19, 0x27, 0x0e, 0x7f, 0x46, 0x4f, 0xec, 0x79, 0x3d,
+	0xa7, 0x8c, 0x69, 0x0e, 0xc0, 0x81, 0x0c, 0xad, 0x6b, 0xdc, 0xdf, 0x8a, 0x0d, 0x5d, 0x35, 0x99,
+	0xd6, 0xf2, 0xb8, 0xec, 0x16, 0x79, 0xfd, 0x2e, 0x7e, 0xa6, 0xa9, 0x34, 0x40, 0x46, 0x56, 0x71,
+	0xd1, 0x53, 0xdc, 0xd4, 0xad, 0xab, 0xca, 0xe4, 0x4a, 0x8b, 0x0d, 0xed, 0xbc, 0x6d, 0x0b, 0x4d,
+	0xdf, 0x6d, 0xf7, 0xa1, 0xbb, 0xdb, 0x87, 0xee, 0xef, 0x7d, 0xe8, 0x7e, 0x3f, 0x84, 0xce, 0xee,
+	0x10, 0x3a, 0x3f, 0x0f, 0xa1, 0xf3, 0xf5, 0x65, 0x26, 0x4c, 0x5e, 0x2d, 0xe2, 0x54, 0x15, 0x49,
+	0xab, 0x4c, 0xe4, 0xd2, 0x24, 0xf6, 0xcf, 0x2d, 0x7c, 0xfb, 0xcf, 0xde, 0xfe, 0x19, 0x00, 0x45,
+	0xaf, 0x3e, 0xf5, 0xb5, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -110,6 +188,109 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.PolicyAddresses) > 0 {
+		for iNdEx := len(m.PolicyAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PolicyAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Policies) > 0 {
+		for iNdEx := len(m.Policies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Policies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PausedDenoms) > 0 {
+		for iNdEx := len(m.PausedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PausedDenoms[iNdEx])
+			copy(dAtA[i:], m.PausedDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.PausedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Hidden) > 0 {
+		for iNdEx := len(m.Hidden) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hidden[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Histories) > 0 {
+		for iNdEx := len(m.Histories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Histories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Collections) > 0 {
 		for iNdEx := len(m.Collections) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -150,6 +331,50 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Histories) > 0 {
+		for _, e := range m.Histories {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Hidden) > 0 {
+		for _, e := range m.Hidden {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PausedDenoms) > 0 {
+		for _, s := range m.PausedDenoms {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Policies) > 0 {
+		for _, e := range m.Policies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PolicyAddresses) > 0 {
+		for _, e := range m.PolicyAddresses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -222,6 +447,275 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Histories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Histories = append(m.Histories, TokenHistory{})
+			if err := m.Histories[len(m.Histories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposits = append(m.Deposits, TokenDeposit{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hidden", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hidden = append(m.Hidden, Hidden{})
+			if err := m.Hidden[len(m.Hidden)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedDenoms = append(m.PausedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policies = append(m.Policies, DenomTransferPolicy{})
+			if err := m.Policies[len(m.Policies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyAddresses = append(m.PolicyAddresses, PolicyAddress{})
+			if err := m.PolicyAddresses[len(m.PolicyAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, GrantAuthorization{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,225 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irismod/nft/types"
+)
+
+func validGenesis() types.GenesisState {
+	nft := types.NewBaseNFT(id, nftName, address, tokenURI, tokenURIHash, tokenData, attributes)
+	collection := types.Collection{
+		Denom: types.NewDenom(denomID, denom, "", "", "", "", 0, false, address),
+		NFTs:  []types.BaseNFT{nft},
+	}
+	grant, err := types.NewGrant(types.NewTransferAuthorization(denomID, nil), time.Now().Add(time.Hour))
+	if err != nil {
+		panic(err)
+	}
+	return *types.NewGenesisState(
+		types.DefaultParams(),
+		[]types.Collection{collection},
+		[]types.TokenHistory{{DenomId: denomID, TokenId: id}},
+		[]types.TokenDeposit{{DenomId: denomID, TokenId: id, Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)}},
+		[]types.Hidden{{DenomId: denomID}, {DenomId: denomID, TokenId: id}},
+		[]string{denomID},
+		[]types.DenomTransferPolicy{{DenomId: denomID, Policy: types.PolicyAllowList}},
+		[]types.PolicyAddress{{DenomId: denomID, List: types.ListAllow, Address: address.String()}},
+		[]types.GrantAuthorization{types.NewGrantAuthorization(address, address2, grant)},
+	)
+}
+
+func TestValidateGenesis(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(data *types.GenesisState)
+		problems []string
+	}{
+		{
+			"valid genesis",
+			func(data *types.GenesisState) {},
+			nil,
+		},
+		{
+			"default genesis",
+			func(data *types.GenesisState) {
+				*data = *types.NewGenesisState(types.DefaultParams(), nil, nil, nil, nil, nil, nil, nil, nil)
+			},
+			nil,
+		},
+		{
+			"invalid denom id",
+			func(data *types.GenesisState) {
+				data.Collections[0].Denom.Id = "1denom"
+				data.Histories = nil
+				data.Deposits = nil
+				data.Hidden = nil
+				data.PausedDenoms = nil
+				data.Policies = nil
+				data.PolicyAddresses = nil
+			},
+			[]string{`collections[0] (denom "1denom")`},
+		},
+		{
+			"duplicated denom id and name",
+			func(data *types.GenesisState) {
+				data.Collections = append(data.Collections, types.Collection{Denom: data.Collections[0].Denom})
+			},
+			[]string{
+				`collections[1] (denom "denom") duplicated denom id`,
+				`collections[1] (denom "denom") duplicated denom name "denom" of collections[0]`,
+			},
+		},
+		{
+			"invalid metadata template",
+			func(data *types.GenesisState) {
+				data.Collections[0].Denom.MetadataTemplate = &types.MetadataTemplate{Image: "image"}
+			},
+			[]string{`collections[0] (denom "denom")`},
+		},
+		{
+			"duplicated token id",
+			func(data *types.GenesisState) {
+				data.Collections[0].NFTs = append(data.Collections[0].NFTs, data.Collections[0].NFTs[0])
+			},
+			[]string{`collections[0] (denom "denom") nfts[1] (token "id1") duplicated token id`},
+		},
+		{
+			"invalid nft",
+			func(data *types.GenesisState) {
+				data.Collections[0].NFTs[0].Owner = nil
+				data.Collections[0].NFTs[0].URIHash = "xyz"
+			},
+			[]string{
+				`collections[0] (denom "denom") nfts[0] (token "id1") missing owner`,
+				`collections[0] (denom "denom") nfts[0] (token "id1")`,
+			},
+		},
+		{
+			"unknown references",
+			func(data *types.GenesisState) {
+				data.Histories[0].DenomId = "unknown"
+				data.Deposits[0].TokenId = "unknown"
+				data.Hidden[1].TokenId = "unknown"
+				data.PausedDenoms[0] = "unknown"
+				data.Policies[0].DenomId = "unknown"
+				data.PolicyAddresses[0].DenomId = "unknown"
+			},
+			[]string{
+				`histories[0] (denom "unknown", token "id1")`,
+				`deposits[0] (denom "denom", token "unknown")`,
+				`hidden[1] (denom "denom", token "unknown")`,
+				`paused_denoms[0] (denom "unknown")`,
+				`policies[0] (denom "unknown")`,
+				`policy_addresses[0] (denom "unknown", list "allow")`,
+			},
+		},
+		{
+			"duplicated entries",
+			func(data *types.GenesisState) {
+				data.Histories = append(data.Histories, data.Histories[0])
+				data.Deposits = append(data.Deposits, data.Deposits[0])
+				data.PausedDenoms = append(data.PausedDenoms, data.PausedDenoms[0])
+				data.Policies = append(data.Policies, data.Policies[0])
+				data.PolicyAddresses = append(data.PolicyAddresses, data.PolicyAddresses[0])
+			},
+			[]string{
+				`histories[1] (denom "denom", token "id1") duplicated history`,
+				`deposits[1] (denom "denom", token "id1") duplicated deposit`,
+				`paused_denoms[1] (denom "denom") duplicated denom`,
+				`policies[1] (denom "denom") duplicated policy`,
+				`policy_addresses[1] (denom "denom", list "allow") duplicated address`,
+			},
+		},
+		{
+			"duplicated ids of another case",
+			func(data *types.GenesisState) {
+				denom := data.Collections[0].Denom
+				denom.Id, denom.Name = "DENOM", "denom2"
+				data.Collections = append(data.Collections, types.Collection{Denom: denom})
+				nft := data.Collections[0].NFTs[0]
+				nft.Id = "ID1"
+				data.Collections[0].NFTs = append(data.Collections[0].NFTs, nft)
+				data.PausedDenoms = append(data.PausedDenoms, "Denom")
+			},
+			[]string{
+				`collections[0] (denom "denom") nfts[1] (token "ID1") duplicated token id`,
+				`collections[1] (denom "DENOM") duplicated denom id`,
+				`paused_denoms[1] (denom "Denom") duplicated denom`,
+			},
+		},
+		{
+			"invalid grants",
+			func(data *types.GenesisState) {
+				data.Grants = append(data.Grants, data.Grants[0], types.GrantAuthorization{Granter: address.String(), Grantee: address.String()})
+			},
+			[]string{
+				`grants[1] (granter "` + address.String() + `", grantee "` + address2.String() + `") duplicated authorization of /irismod.nft.MsgTransferNFT`,
+				`grants[2] (granter "` + address.String() + `", grantee "` + address.String() + `"): granter and grantee can't be the same`,
+				`grants[2] (granter "` + address.String() + `", grantee "` + address.String() + `") missing expiration`,
+				`grants[2] (granter "` + address.String() + `", grantee "` + address.String() + `") missing authorization`,
+			},
+		},
+		{
+			"invalid policy entries",
+			func(data *types.GenesisState) {
+				data.Policies[0].Policy = ""
+				data.PolicyAddresses[0].List = "grey"
+				data.PolicyAddresses[0].Address = "invalid"
+			},
+			[]string{
+				`policies[0] (denom "denom") empty policy`,
+				`policy_addresses[0] (denom "denom", list "grey")`,
+				`invalid policy address invalid`,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data := validGenesis()
+			tc.malleate(&data)
+
+			err := types.ValidateGenesis(data)
+			if len(tc.problems) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			require.True(t, types.ErrInvalidGenesis.Is(err))
+			for _, problem := range tc.problems {
+				require.Contains(t, err.Error(), problem)
+			}
+		})
+	}
+}
+
+func TestValidateGenesisPolicies(t *testing.T) {
+	data := validGenesis()
+	require.NoError(t, types.ValidateGenesisPolicies(data, types.IsBuiltinPolicy))
+
+	data.Policies[0].Policy = "royalty"
+	err := types.ValidateGenesisPolicies(data, types.IsBuiltinPolicy)
+	require.Error(t, err)
+	require.True(t, types.ErrInvalidGenesis.Is(err))
+	require.Contains(t, err.Error(), `policies[0] (denom "denom"): unknown transfer policy royalty`)
+	require.NoError(t, types.ValidateGenesisPolicies(data, func(name string) bool { return name == "royalty" }))
+}
+
+func TestValidateGenesisReportsEveryProblem(t *testing.T) {
+	data := validGenesis()
+	data.Collections[0].NFTs[0].Owner = nil
+	data.PausedDenoms = append(data.PausedDenoms, "unknown")
+	data.Policies[0].Policy = ""
+
+	err := types.ValidateGenesis(data)
+	require.Error(t, err)
+	require.True(t, strings.HasPrefix(err.Error(), "3 problem(s) found:"), err.Error())
+}
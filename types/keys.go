@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"encoding/binary"
 	"errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -27,10 +28,26 @@ var (
 	PrefixCollection = []byte{0x03} // key for balance of NFTs held by the denom
 	PrefixDenom      = []byte{0x04} // key for denom of the nft
 	PrefixDenomName  = []byte{0x05} // key for denom name of the nft
+	PrefixTrait      = []byte{0x06} // key for the trait index of the nft
+	PrefixTraitCount = []byte{0x07} // key for the number of nft holding a trait
+	PrefixHistory    = []byte{0x08} // key for the history entries of the nft
+	PrefixHistorySeq = []byte{0x09} // key for the next history sequence of the nft
+	PrefixDeposit    = []byte{0x0A} // key for the storage deposit locked for the nft
+	PrefixHidden     = []byte{0x0B} // key for the nft and denoms hidden by governance
+	PrefixPaused     = []byte{0x0C} // key for the denoms paused by their creator
+	PrefixPolicy     = []byte{0x0D} // key for the transfer policy of the denom
+	PrefixPolicyList = []byte{0x0E} // key for the addresses of the policy lists of the denom
+	PrefixVersion    = []byte{0x0F} // key for the consensus version of the store
+	PrefixGrant      = []byte{0x10} // key for the authorizations granted by an account
 
 	delimiter = []byte("/")
 )
 
+// KeyConsensusVersion gets the key of the consensus version of the store
+func KeyConsensusVersion() []byte {
+	return append(PrefixVersion, delimiter...)
+}
+
 // SplitKeyOwner return the address,denom,id from the key of stored owner
 func SplitKeyOwner(key []byte) (address sdk.AccAddress, denom, id string, err error) {
 	key = key[len(PrefixOwners)+len(delimiter):]
@@ -96,3 +113,213 @@ func KeyDenomName(name string) []byte {
 	key := append(PrefixDenomName, delimiter...)
 	return append(key, []byte(name)...)
 }
+
+// KeyTrait gets the key of the trait index entry by the denom, trait and token id
+func KeyTrait(denomID, key, value, tokenID string) []byte {
+	key0 := append(PrefixTrait, delimiter...)
+	key0 = append(key0, []byte(denomID)...)
+	key0 = append(key0, delimiter...)
+	key0 = append(key0, []byte(key)...)
+	key0 = append(key0, delimiter...)
+	key0 = append(key0, []byte(value)...)
+	key0 = append(key0, delimiter...)
+	return append(key0, []byte(tokenID)...)
+}
+
+// KeyTraitCount gets the key of the trait counter by the denom and trait,
+// an empty key or value returns the prefix of the remaining counters
+func KeyTraitCount(denomID, key, value string) []byte {
+	key0 := append(PrefixTraitCount, delimiter...)
+	key0 = append(key0, []byte(denomID)...)
+	key0 = append(key0, delimiter...)
+	if len(key) > 0 {
+		key0 = append(key0, []byte(key)...)
+		key0 = append(key0, delimiter...)
+	}
+
+	if len(key) > 0 && len(value) > 0 {
+		key0 = append(key0, []byte(value)...)
+	}
+	return key0
+}
+
+// SplitKeyTraitCount return the key and value from the trait counter key
+// with the denom prefix stripped
+func SplitKeyTraitCount(key []byte) (traitKey, traitValue string, err error) {
+	keys := bytes.SplitN(key, delimiter, 2)
+	if len(keys) != 2 {
+		return traitKey, traitValue, errors.New("wrong KeyTraitCount")
+	}
+	return string(keys[0]), string(keys[1]), nil
+}
+
+// KeyHistory gets the key prefix of the history entries by the denom and token id
+func KeyHistory(denomID, tokenID string) []byte {
+	key := append(PrefixHistory, delimiter...)
+	if len(denomID) > 0 {
+		key = append(key, []byte(denomID)...)
+		key = append(key, delimiter...)
+	}
+
+	if len(denomID) > 0 && len(tokenID) > 0 {
+		key = append(key, []byte(tokenID)...)
+		key = append(key, delimiter...)
+	}
+	return key
+}
+
+// SplitKeyHistoryEntry return the denom,id from the key of a stored history entry
+func SplitKeyHistoryEntry(key []byte) (denomID, tokenID string, err error) {
+	if len(key) < len(PrefixHistory)+len(delimiter)+8 {
+		return denomID, tokenID, errors.New("wrong KeyHistoryEntry")
+	}
+
+	key = key[len(PrefixHistory)+len(delimiter) : len(key)-8]
+	keys := bytes.Split(key, delimiter)
+	if len(keys) != 3 {
+		return denomID, tokenID, errors.New("wrong KeyHistoryEntry")
+	}
+	return string(keys[0]), string(keys[1]), nil
+}
+
+// KeyHistoryEntry gets the key of a history entry, the big endian sequence
+// keeps the entries of a token sorted from the oldest to the newest
+func KeyHistoryEntry(denomID, tokenID string, sequence uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, sequence)
+	return append(KeyHistory(denomID, tokenID), bz...)
+}
+
+// KeyHistorySequence gets the key of the next history sequence by the denom and token id
+func KeyHistorySequence(denomID, tokenID string) []byte {
+	key := append(PrefixHistorySeq, delimiter...)
+	key = append(key, []byte(denomID)...)
+	key = append(key, delimiter...)
+	return append(key, []byte(tokenID)...)
+}
+
+// KeyDeposit gets the key of the storage deposit by the denom and token id
+func KeyDeposit(denomID, tokenID string) []byte {
+	key := append(PrefixDeposit, delimiter...)
+	if len(denomID) > 0 {
+		key = append(key, []byte(denomID)...)
+		key = append(key, delimiter...)
+	}
+
+	if len(denomID) > 0 && len(tokenID) > 0 {
+		key = append(key, []byte(tokenID)...)
+	}
+	return key
+}
+
+// SplitKeyDeposit return the denom,id from the key of a stored deposit
+func SplitKeyDeposit(key []byte) (denomID, tokenID string, err error) {
+	key = key[len(PrefixDeposit)+len(delimiter):]
+	keys := bytes.Split(key, delimiter)
+	if len(keys) != 2 {
+		return denomID, tokenID, errors.New("wrong KeyDeposit")
+	}
+	return string(keys[0]), string(keys[1]), nil
+}
+
+// KeyHidden gets the key of the hidden flag by the denom and token id, an
+// empty token id gets the key of the denom flag
+func KeyHidden(denomID, tokenID string) []byte {
+	key := append(PrefixHidden, delimiter...)
+	if len(denomID) > 0 {
+		key = append(key, []byte(denomID)...)
+		key = append(key, delimiter...)
+	}
+
+	if len(denomID) > 0 && len(tokenID) > 0 {
+		key = append(key, []byte(tokenID)...)
+	}
+	return key
+}
+
+// SplitKeyHidden return the denom,id from the key of a hidden flag
+func SplitKeyHidden(key []byte) (denomID, tokenID string, err error) {
+	key = key[len(PrefixHidden)+len(delimiter):]
+	keys := bytes.Split(key, delimiter)
+	if len(keys) != 2 {
+		return denomID, tokenID, errors.New("wrong KeyHidden")
+	}
+	return string(keys[0]), string(keys[1]), nil
+}
+
+// KeyPaused gets the key of the pause flag of the denom
+func KeyPaused(denomID string) []byte {
+	key := append(PrefixPaused, delimiter...)
+	return append(key, []byte(denomID)...)
+}
+
+// KeyPolicy gets the key of the transfer policy of the denom
+func KeyPolicy(denomID string) []byte {
+	key := append(PrefixPolicy, delimiter...)
+	return append(key, []byte(denomID)...)
+}
+
+// KeyPolicyList gets the key of an address of a policy list of the denom
+func KeyPolicyList(denomID, list string, address sdk.AccAddress) []byte {
+	key := append(PrefixPolicyList, delimiter...)
+	if len(denomID) > 0 {
+		key = append(key, []byte(denomID)...)
+		key = append(key, delimiter...)
+	}
+
+	if len(denomID) > 0 && len(list) > 0 {
+		key = append(key, []byte(list)...)
+		key = append(key, delimiter...)
+	}
+
+	if len(denomID) > 0 && len(list) > 0 && len(address) > 0 {
+		key = append(key, address.Bytes()...)
+	}
+	return key
+}
+
+// SplitKeyPolicyList return the denom, list and address from the key of a policy list entry
+func SplitKeyPolicyList(key []byte) (denomID, list string, address sdk.AccAddress, err error) {
+	key = key[len(PrefixPolicyList)+len(delimiter):]
+	keys := bytes.SplitN(key, delimiter, 3)
+	if len(keys) != 3 {
+		return denomID, list, address, errors.New("wrong KeyPolicyList")
+	}
+	return string(keys[0]), string(keys[1]), sdk.AccAddress(keys[2]), nil
+}
+
+// KeyGrant gets the key of the grant by the granter, grantee and message type url
+func KeyGrant(granter, grantee sdk.AccAddress, msgTypeURL string) []byte {
+	key := append(PrefixGrant, delimiter...)
+	if granter != nil {
+		key = append(key, []byte(granter.String())...)
+		key = append(key, delimiter...)
+	}
+
+	if granter != nil && grantee != nil {
+		key = append(key, []byte(grantee.String())...)
+		key = append(key, delimiter...)
+	}
+
+	if granter != nil && grantee != nil && len(msgTypeURL) > 0 {
+		key = append(key, []byte(msgTypeURL)...)
+	}
+	return key
+}
+
+// SplitKeyGrant return the granter, grantee and message type url from the key of a grant
+func SplitKeyGrant(key []byte) (granter, grantee sdk.AccAddress, msgTypeURL string, err error) {
+	key = key[len(PrefixGrant)+len(delimiter):]
+	keys := bytes.SplitN(key, delimiter, 3)
+	if len(keys) != 3 {
+		return granter, grantee, msgTypeURL, errors.New("wrong KeyGrant")
+	}
+
+	if granter, err = sdk.AccAddressFromBech32(string(keys[0])); err != nil {
+		return granter, grantee, msgTypeURL, err
+	}
+	if grantee, err = sdk.AccAddressFromBech32(string(keys[1])); err != nil {
+		return granter, grantee, msgTypeURL, err
+	}
+	return granter, grantee, string(keys[2]), nil
+}
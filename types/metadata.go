@@ -0,0 +1,203 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxMetadataPointerLen is the maximum length of a JSON pointer of a metadata template
+const MaxMetadataPointerLen = 128
+
+// DisplayTypeNumber is the ERC-721 display type of the numeric attributes
+const DisplayTypeNumber = "number"
+
+// DefaultMetadataTemplate returns the template used by the denoms without one,
+// it reads the fields of the same name at the root of the data
+func DefaultMetadataTemplate() MetadataTemplate {
+	return MetadataTemplate{
+		Description:  "/description",
+		Image:        "/image",
+		ExternalURL:  "/external_url",
+		AnimationURL: "/animation_url",
+		Attributes:   "/attributes",
+	}
+}
+
+// ValidateMetadataTemplate checks that the fields of the template are either
+// empty, which leaves the metadata field unmapped, or JSON pointers
+func ValidateMetadataTemplate(template *MetadataTemplate) error {
+	if template == nil {
+		return nil
+	}
+
+	for _, field := range []struct{ name, pointer string }{
+		{"description", template.Description},
+		{"image", template.Image},
+		{"external_url", template.ExternalURL},
+		{"animation_url", template.AnimationURL},
+		{"attributes", template.Attributes},
+	} {
+		if len(field.pointer) == 0 {
+			continue
+		}
+		if len(field.pointer) > MaxMetadataPointerLen || !utf8.ValidString(field.pointer) || !strings.HasPrefix(field.pointer, "/") {
+			return sdkerrors.Wrapf(ErrInvalidMetadataTemplate, "invalid %s pointer %s, only accepts a JSON pointer of length [1, %d]", field.name, field.pointer, MaxMetadataPointerLen)
+		}
+	}
+	return nil
+}
+
+// RenderMetadata returns the ERC-721 metadata JSON of the NFT. The fields are
+// read from its data with the template of the denom, the data of a NFT not
+// holding a JSON document is its description and the image defaults to its
+// uri resolved against the base uri of the denom. The attributes of the NFT come first, the ones of the data with the
+// same trait type are dropped.
+func RenderMetadata(denom Denom, nft BaseNFT) QueryMetadataResponse {
+	template := DefaultMetadataTemplate()
+	if denom.MetadataTemplate != nil {
+		template = *denom.MetadataTemplate
+	}
+
+	metadata := QueryMetadataResponse{
+		Name:       nft.Name,
+		Attributes: []MetadataAttribute{},
+	}
+
+	seen := make(map[string]bool, len(nft.Attributes))
+	for _, attr := range nft.Attributes {
+		attribute := MetadataAttribute{TraitType: attr.Key, Value: attr.Value}
+		if attr.Type == AttributeTypeNumber {
+			attribute.DisplayType = DisplayTypeNumber
+		}
+		metadata.Attributes = append(metadata.Attributes, attribute)
+		seen[attr.Key] = true
+	}
+
+	data, ok := decodeJSON(nft.Data)
+	if !ok {
+		metadata.Description = nft.Data
+		metadata.Image = denom.TokenURI(nft.Id, nft.URI)
+		return metadata
+	}
+
+	metadata.Description = stringValue(lookupPointer(data, template.Description))
+	metadata.Image = stringValue(lookupPointer(data, template.Image))
+	metadata.ExternalURL = stringValue(lookupPointer(data, template.ExternalURL))
+	metadata.AnimationURL = stringValue(lookupPointer(data, template.AnimationURL))
+	if len(metadata.Image) == 0 {
+		metadata.Image = denom.TokenURI(nft.Id, nft.URI)
+	}
+
+	for _, attribute := range dataAttributes(lookupPointer(data, template.Attributes)) {
+		if seen[attribute.TraitType] {
+			continue
+		}
+		metadata.Attributes = append(metadata.Attributes, attribute)
+		seen[attribute.TraitType] = true
+	}
+	return metadata
+}
+
+// dataAttributes converts either an array of ERC-721 attributes or an object
+// of trait types to values into metadata attributes
+func dataAttributes(v interface{}) (attributes []MetadataAttribute) {
+	switch v := v.(type) {
+	case []interface{}:
+		for _, item := range v {
+			object, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			attribute := MetadataAttribute{
+				TraitType:   stringValue(object["trait_type"]),
+				Value:       stringValue(object["value"]),
+				DisplayType: stringValue(object["display_type"]),
+			}
+			if len(attribute.TraitType) == 0 {
+				continue
+			}
+			attributes = append(attributes, attribute)
+		}
+
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			attribute := MetadataAttribute{TraitType: key, Value: stringValue(v[key])}
+			if _, ok := v[key].(json.Number); ok {
+				attribute.DisplayType = DisplayTypeNumber
+			}
+			attributes = append(attributes, attribute)
+		}
+	}
+	return attributes
+}
+
+// decodeJSON decodes the data, keeping the numbers as they were written
+func decodeJSON(data string) (interface{}, bool) {
+	decoder := json.NewDecoder(strings.NewReader(data))
+	decoder.UseNumber()
+
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil || decoder.More() {
+		return nil, false
+	}
+	return v, true
+}
+
+// lookupPointer returns the value the JSON pointer (RFC 6901) refers to in the
+// document, nil if the pointer is empty or the value doesn't exist
+func lookupPointer(document interface{}, pointer string) interface{} {
+	if !strings.HasPrefix(pointer, "/") {
+		return nil
+	}
+
+	v := document
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		switch node := v.(type) {
+		case map[string]interface{}:
+			v = node[token]
+		case []interface{}:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(node) {
+				return nil
+			}
+			v = node[i]
+		default:
+			return nil
+		}
+	}
+	return v
+}
+
+// stringValue returns the strings as is and the other JSON values encoded
+func stringValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		return ""
+	}
+	return strings.TrimSuffix(buf.String(), "\n")
+}
@@ -0,0 +1,123 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/irismod/nft/types"
+)
+
+func TestValidateMetadataTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template *types.MetadataTemplate
+		wantErr  bool
+	}{
+		{"nil", nil, false},
+		{"empty", &types.MetadataTemplate{}, false},
+		{"pointers", &types.MetadataTemplate{Description: "/desc", Image: "/media/0/url", Attributes: "/traits"}, false},
+		{"root", &types.MetadataTemplate{Description: "/"}, false},
+		{"relative", &types.MetadataTemplate{Image: "image"}, true},
+		{"too long", &types.MetadataTemplate{ExternalURL: "/" + strings.Repeat("a", types.MaxMetadataPointerLen)}, true},
+		{"invalid utf8", &types.MetadataTemplate{AnimationURL: "/\xff"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := types.ValidateMetadataTemplate(tt.template)
+			if tt.wantErr {
+				require.True(t, types.ErrInvalidMetadataTemplate.Is(err))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRenderMetadata(t *testing.T) {
+	attributes := []types.Attribute{
+		types.NewAttribute("rarity", "legendary", ""),
+		types.NewAttribute("level", "3", types.AttributeTypeNumber),
+	}
+
+	tests := []struct {
+		name     string
+		template *types.MetadataTemplate
+		nft      types.BaseNFT
+		want     types.QueryMetadataResponse
+	}{
+		{
+			name: "default template",
+			nft: types.BaseNFT{
+				Name:       "Tom",
+				URI:        "ipfs://tom",
+				Data:       `{"description":"a cat","image":"ipfs://tom.png","external_url":"https://example.com/tom","attributes":[{"trait_type":"color","value":"grey"},{"trait_type":"rarity","value":"common"},{"value":"untyped"}]}`,
+				Attributes: attributes,
+			},
+			want: types.QueryMetadataResponse{
+				Name:        "Tom",
+				Description: "a cat",
+				Image:       "ipfs://tom.png",
+				ExternalURL: "https://example.com/tom",
+				Attributes: []types.MetadataAttribute{
+					{TraitType: "rarity", Value: "legendary"},
+					{TraitType: "level", Value: "3", DisplayType: types.DisplayTypeNumber},
+					{TraitType: "color", Value: "grey"},
+				},
+			},
+		},
+		{
+			name:     "custom template",
+			template: &types.MetadataTemplate{Description: "/info/text", Image: "/media/1", AnimationURL: "/media/0", Attributes: "/traits"},
+			nft: types.BaseNFT{
+				Name: "Tom",
+				URI:  "ipfs://tom",
+				Data: `{"info":{"text":"a cat"},"media":["ipfs://tom.mp4","ipfs://tom.png"],"traits":{"speed":12.5,"legs":{"front":2},"wild":false},"description":"ignored"}`,
+			},
+			want: types.QueryMetadataResponse{
+				Name:         "Tom",
+				Description:  "a cat",
+				Image:        "ipfs://tom.png",
+				AnimationURL: "ipfs://tom.mp4",
+				Attributes: []types.MetadataAttribute{
+					{TraitType: "legs", Value: `{"front":2}`},
+					{TraitType: "speed", Value: "12.5", DisplayType: types.DisplayTypeNumber},
+					{TraitType: "wild", Value: "false"},
+				},
+			},
+		},
+		{
+			name:     "unmapped fields",
+			template: &types.MetadataTemplate{},
+			nft:      types.BaseNFT{Name: "Tom", URI: "ipfs://tom", Data: `{"description":"a cat"}`},
+			want:     types.QueryMetadataResponse{Name: "Tom", Image: "ipfs://tom", Attributes: []types.MetadataAttribute{}},
+		},
+		{
+			name: "escaped pointer",
+			template: &types.MetadataTemplate{
+				Description: "/a~1b/c~0d",
+			},
+			nft:  types.BaseNFT{Name: "Tom", Data: `{"a/b":{"c~d":"a cat"}}`},
+			want: types.QueryMetadataResponse{Name: "Tom", Description: "a cat", Attributes: []types.MetadataAttribute{}},
+		},
+		{
+			name: "plain data",
+			nft:  types.BaseNFT{Name: "Tom", URI: "ipfs://tom", Data: "a cat", Attributes: attributes[:1]},
+			want: types.QueryMetadataResponse{
+				Name:        "Tom",
+				Description: "a cat",
+				Image:       "ipfs://tom",
+				Attributes:  []types.MetadataAttribute{{TraitType: "rarity", Value: "legendary"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			denom := types.Denom{Id: "kitty", MetadataTemplate: tt.template}
+			require.Equal(t, tt.want, types.RenderMetadata(denom, tt.nft))
+		})
+	}
+}
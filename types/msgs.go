@@ -3,8 +3,10 @@ package types
 import (
 	"regexp"
 	"strings"
+	"time"
 	"unicode/utf8"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -16,6 +18,11 @@ const (
 	MaxDenomLen = 64
 
 	MaxTokenURILen = 256
+
+	// DefaultHistoryRetention is the number of history entries kept per token
+	// when the denom is issued without a retention
+	DefaultHistoryRetention uint64 = 100
+	MaxHistoryRetention     uint64 = 10000
 )
 
 var (
@@ -25,12 +32,17 @@ var (
 )
 
 // NewMsgIssueDenom is a constructor function for MsgSetName
-func NewMsgIssueDenom(id, name, schema string, sender sdk.AccAddress) *MsgIssueDenom {
+func NewMsgIssueDenom(id, name, schema, uri, uriHash, baseURI string, historyRetention uint64, revocable bool, sender string) *MsgIssueDenom {
 	return &MsgIssueDenom{
-		Sender: sender,
-		Id:     strings.ToLower(strings.TrimSpace(id)),
-		Name:   strings.TrimSpace(name),
-		Schema: strings.TrimSpace(schema),
+		Sender:           sender,
+		Id:               strings.ToLower(strings.TrimSpace(id)),
+		Name:             strings.TrimSpace(name),
+		Schema:           strings.TrimSpace(schema),
+		URI:              strings.TrimSpace(uri),
+		URIHash:          strings.ToLower(strings.TrimSpace(uriHash)),
+		BaseURI:          strings.TrimSpace(baseURI),
+		HistoryRetention: historyRetention,
+		Revocable:        revocable,
 	}
 }
 
@@ -51,10 +63,22 @@ func (msg MsgIssueDenom) ValidateBasic() error {
 		return sdkerrors.Wrap(ErrInvalidDenom, "denom name is invalid")
 	}
 
-	if msg.Sender.Empty() {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
 	}
-	return nil
+
+	if err := ValidateTokenURI(msg.URI); err != nil {
+		return err
+	}
+
+	if err := ValidateBaseURI(msg.BaseURI); err != nil {
+		return err
+	}
+
+	if err := ValidateHistoryRetention(msg.HistoryRetention); err != nil {
+		return err
+	}
+	return ValidateURIHash(msg.URIHash)
 }
 
 // GetSignBytes Implements Msg.
@@ -65,18 +89,23 @@ func (msg MsgIssueDenom) GetSignBytes() []byte {
 
 // GetSigners Implements Msg.
 func (msg MsgIssueDenom) GetSigners() []sdk.AccAddress {
-	return []sdk.AccAddress{msg.Sender}
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
 }
 
 // NewMsgTransferNFT is a constructor function for MsgSetName
 func NewMsgTransferNFT(
-	id, denom, name, tokenURI, tokenData string,
-	sender, recipient sdk.AccAddress) *MsgTransferNFT {
+	id, denom, name, tokenURI, tokenURIHash, tokenData string,
+	sender, recipient string) *MsgTransferNFT {
 	return &MsgTransferNFT{
 		Id:        strings.ToLower(strings.TrimSpace(id)),
 		Denom:     strings.TrimSpace(denom),
 		Name:      strings.TrimSpace(name),
 		URI:       strings.TrimSpace(tokenURI),
+		URIHash:   strings.ToLower(strings.TrimSpace(tokenURIHash)),
 		Data:      strings.TrimSpace(tokenData),
 		Sender:    sender,
 		Recipient: recipient,
@@ -95,12 +124,16 @@ func (msg MsgTransferNFT) ValidateBasic() error {
 		return err
 	}
 
-	if msg.Sender.Empty() {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
 	}
 
-	if msg.Recipient.Empty() {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing recipient address")
+	if err := ValidateURIHash(msg.URIHash); err != nil {
+		return err
 	}
 	return ValidateTokenID(msg.Id)
 }
@@ -113,19 +146,26 @@ func (msg MsgTransferNFT) GetSignBytes() []byte {
 
 // GetSigners Implements Msg.
 func (msg MsgTransferNFT) GetSigners() []sdk.AccAddress {
-	return []sdk.AccAddress{msg.Sender}
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
 }
 
 // NewMsgEditNFT is a constructor function for MsgSetName
 func NewMsgEditNFT(
-	id, denom, name, tokenURI, tokenData string, sender sdk.AccAddress) *MsgEditNFT {
+	id, denom, name, tokenURI, tokenURIHash, tokenData string,
+	attributes []Attribute, sender string) *MsgEditNFT {
 	return &MsgEditNFT{
-		Id:     strings.ToLower(strings.TrimSpace(id)),
-		Denom:  strings.TrimSpace(denom),
-		Name:   strings.TrimSpace(name),
-		URI:    strings.TrimSpace(tokenURI),
-		Data:   strings.TrimSpace(tokenData),
-		Sender: sender,
+		Id:         strings.ToLower(strings.TrimSpace(id)),
+		Denom:      strings.TrimSpace(denom),
+		Name:       strings.TrimSpace(name),
+		URI:        strings.TrimSpace(tokenURI),
+		URIHash:    strings.ToLower(strings.TrimSpace(tokenURIHash)),
+		Data:       strings.TrimSpace(tokenData),
+		Attributes: attributes,
+		Sender:     sender,
 	}
 }
 
@@ -137,8 +177,8 @@ func (msg MsgEditNFT) Type() string { return "edit_nft" }
 
 // ValidateBasic Implements Msg.
 func (msg MsgEditNFT) ValidateBasic() error {
-	if msg.Sender.Empty() {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
 	}
 
 	if err := ValidateDenomID(msg.Denom); err != nil {
@@ -148,6 +188,16 @@ func (msg MsgEditNFT) ValidateBasic() error {
 	if err := ValidateTokenURI(msg.URI); err != nil {
 		return err
 	}
+
+	if err := ValidateURIHash(msg.URIHash); err != nil {
+		return err
+	}
+	if err := ValidateAttributes(msg.Attributes); err != nil {
+		return err
+	}
+	if msg.ClearAttributes && !IsDoNotModifyAttributes(msg.Attributes) {
+		return sdkerrors.Wrap(ErrInvalidAttribute, "attributes can't be set while clearing them")
+	}
 	return ValidateTokenID(msg.Id)
 }
 
@@ -159,21 +209,27 @@ func (msg MsgEditNFT) GetSignBytes() []byte {
 
 // GetSigners Implements Msg.
 func (msg MsgEditNFT) GetSigners() []sdk.AccAddress {
-	return []sdk.AccAddress{msg.Sender}
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
 }
 
 // NewMsgMintNFT is a constructor function for MsgMintNFT
 func NewMsgMintNFT(
-	id, denom, name, tokenURI, tokenData string,
-	sender, recipient sdk.AccAddress) *MsgMintNFT {
+	id, denom, name, tokenURI, tokenURIHash, tokenData string,
+	attributes []Attribute, sender, recipient string) *MsgMintNFT {
 	return &MsgMintNFT{
-		Id:        strings.ToLower(strings.TrimSpace(id)),
-		Denom:     strings.TrimSpace(denom),
-		Name:      strings.TrimSpace(name),
-		URI:       strings.TrimSpace(tokenURI),
-		Data:      strings.TrimSpace(tokenData),
-		Sender:    sender,
-		Recipient: recipient,
+		Id:         strings.ToLower(strings.TrimSpace(id)),
+		Denom:      strings.TrimSpace(denom),
+		Name:       strings.TrimSpace(name),
+		URI:        strings.TrimSpace(tokenURI),
+		URIHash:    strings.ToLower(strings.TrimSpace(tokenURIHash)),
+		Data:       strings.TrimSpace(tokenData),
+		Attributes: attributes,
+		Sender:     sender,
+		Recipient:  recipient,
 	}
 }
 
@@ -185,11 +241,11 @@ func (msg MsgMintNFT) Type() string { return "mint_nft" }
 
 // ValidateBasic Implements Msg.
 func (msg MsgMintNFT) ValidateBasic() error {
-	if msg.Sender.Empty() {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
 	}
-	if msg.Recipient.Empty() {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing receipt address")
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
 	}
 	if err := ValidateDenomID(msg.Denom); err != nil {
 		return err
@@ -198,6 +254,13 @@ func (msg MsgMintNFT) ValidateBasic() error {
 	if err := ValidateTokenURI(msg.URI); err != nil {
 		return err
 	}
+
+	if err := ValidateURIHash(msg.URIHash); err != nil {
+		return err
+	}
+	if err := ValidateAttributes(msg.Attributes); err != nil {
+		return err
+	}
 	return ValidateTokenID(msg.Id)
 }
 
@@ -209,11 +272,15 @@ func (msg MsgMintNFT) GetSignBytes() []byte {
 
 // GetSigners Implements Msg.
 func (msg MsgMintNFT) GetSigners() []sdk.AccAddress {
-	return []sdk.AccAddress{msg.Sender}
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
 }
 
 // NewMsgBurnNFT is a constructor function for MsgBurnNFT
-func NewMsgBurnNFT(sender sdk.AccAddress, id string, denom string) *MsgBurnNFT {
+func NewMsgBurnNFT(sender string, id string, denom string) *MsgBurnNFT {
 	return &MsgBurnNFT{
 		Sender: sender,
 		Id:     strings.ToLower(strings.TrimSpace(id)),
@@ -229,8 +296,8 @@ func (msg MsgBurnNFT) Type() string { return "burn_nft" }
 
 // ValidateBasic Implements Msg.
 func (msg MsgBurnNFT) ValidateBasic() error {
-	if msg.Sender.Empty() {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
 	}
 
 	if err := ValidateDenomID(msg.Denom); err != nil {
@@ -247,5 +314,465 @@ func (msg MsgBurnNFT) GetSignBytes() []byte {
 
 // GetSigners Implements Msg.
 func (msg MsgBurnNFT) GetSigners() []sdk.AccAddress {
-	return []sdk.AccAddress{msg.Sender}
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgPauseDenom is a constructor function for MsgPauseDenom
+func NewMsgPauseDenom(denom, sender string) *MsgPauseDenom {
+	return &MsgPauseDenom{
+		Denom:  strings.ToLower(strings.TrimSpace(denom)),
+		Sender: sender,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgPauseDenom) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgPauseDenom) Type() string { return "pause_denom" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgPauseDenom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return ValidateDenomID(msg.Denom)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgPauseDenom) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgPauseDenom) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgUnpauseDenom is a constructor function for MsgUnpauseDenom
+func NewMsgUnpauseDenom(denom, sender string) *MsgUnpauseDenom {
+	return &MsgUnpauseDenom{
+		Denom:  strings.ToLower(strings.TrimSpace(denom)),
+		Sender: sender,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgUnpauseDenom) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgUnpauseDenom) Type() string { return "unpause_denom" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgUnpauseDenom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return ValidateDenomID(msg.Denom)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgUnpauseDenom) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgUnpauseDenom) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgSetTransferPolicy is a constructor function for MsgSetTransferPolicy
+func NewMsgSetTransferPolicy(denom, policy, sender string) *MsgSetTransferPolicy {
+	return &MsgSetTransferPolicy{
+		Denom:  strings.ToLower(strings.TrimSpace(denom)),
+		Policy: strings.TrimSpace(policy),
+		Sender: sender,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgSetTransferPolicy) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgSetTransferPolicy) Type() string { return "set_transfer_policy" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetTransferPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return ValidateDenomID(msg.Denom)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetTransferPolicy) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetTransferPolicy) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgUpdatePolicyList is a constructor function for MsgUpdatePolicyList
+func NewMsgUpdatePolicyList(denom, list string, add, remove []string, sender string) *MsgUpdatePolicyList {
+	return &MsgUpdatePolicyList{
+		Denom:  strings.ToLower(strings.TrimSpace(denom)),
+		List:   strings.ToLower(strings.TrimSpace(list)),
+		Add:    add,
+		Remove: remove,
+		Sender: sender,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgUpdatePolicyList) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgUpdatePolicyList) Type() string { return "update_policy_list" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgUpdatePolicyList) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := ValidateDenomID(msg.Denom); err != nil {
+		return err
+	}
+	if err := ValidatePolicyList(msg.List); err != nil {
+		return err
+	}
+	if len(msg.Add) == 0 && len(msg.Remove) == 0 {
+		return sdkerrors.Wrap(ErrInvalidPolicy, "no address to add or remove")
+	}
+	if err := ValidatePolicyAddresses(append(append([]string{}, msg.Add...), msg.Remove...)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgUpdatePolicyList) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgUpdatePolicyList) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgRevokeNFT is a constructor function for MsgRevokeNFT, an empty
+// recipient burns the NFT
+func NewMsgRevokeNFT(id, denom, sender, recipient string) *MsgRevokeNFT {
+	return &MsgRevokeNFT{
+		Id:        strings.ToLower(strings.TrimSpace(id)),
+		Denom:     strings.ToLower(strings.TrimSpace(denom)),
+		Sender:    sender,
+		Recipient: recipient,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgRevokeNFT) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgRevokeNFT) Type() string { return "revoke_nft" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRevokeNFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if len(msg.Recipient) > 0 {
+		if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+		}
+	}
+	if err := ValidateDenomID(msg.Denom); err != nil {
+		return err
+	}
+	return ValidateTokenID(msg.Id)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRevokeNFT) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRevokeNFT) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgSetMetadataTemplate is a constructor function for MsgSetMetadataTemplate
+func NewMsgSetMetadataTemplate(denom string, template *MetadataTemplate, sender string) *MsgSetMetadataTemplate {
+	return &MsgSetMetadataTemplate{
+		Denom:    strings.ToLower(strings.TrimSpace(denom)),
+		Template: template,
+		Sender:   sender,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgSetMetadataTemplate) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgSetMetadataTemplate) Type() string { return "set_metadata_template" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetMetadataTemplate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := ValidateDenomID(msg.Denom); err != nil {
+		return err
+	}
+	return ValidateMetadataTemplate(msg.Template)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetMetadataTemplate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetMetadataTemplate) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgGrantAuthorization is a constructor function for MsgGrantAuthorization
+func NewMsgGrantAuthorization(granter, grantee string, authorization Authorization, expiration time.Time) (*MsgGrantAuthorization, error) {
+	any, err := codectypes.NewAnyWithValue(authorization)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgGrantAuthorization{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: any,
+		Expiration:    expiration,
+	}, nil
+}
+
+// GetAuthorization returns the granted authorization, nil if it can't be
+// unpacked
+func (msg MsgGrantAuthorization) GetAuthorization() Authorization {
+	return unpackAuthorization(msg.Authorization)
+}
+
+// Route Implements Msg
+func (msg MsgGrantAuthorization) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgGrantAuthorization) Type() string { return "grant_authorization" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgGrantAuthorization) ValidateBasic() error {
+	if err := validateGrantees(msg.Granter, msg.Grantee); err != nil {
+		return err
+	}
+	if msg.Expiration.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidGrant, "missing expiration")
+	}
+
+	authorization := msg.GetAuthorization()
+	if authorization == nil {
+		return sdkerrors.Wrap(ErrInvalidGrant, "missing authorization")
+	}
+	return authorization.ValidateBasic()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgGrantAuthorization) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgGrantAuthorization) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgGrantAuthorization) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var authorization Authorization
+	return unpacker.UnpackAny(msg.Authorization, &authorization)
+}
+
+// NewMsgRevokeAuthorization is a constructor function for MsgRevokeAuthorization
+func NewMsgRevokeAuthorization(granter, grantee, msgTypeURL string) *MsgRevokeAuthorization {
+	return &MsgRevokeAuthorization{
+		Granter:    granter,
+		Grantee:    grantee,
+		MsgTypeURL: strings.TrimSpace(msgTypeURL),
+	}
+}
+
+// Route Implements Msg
+func (msg MsgRevokeAuthorization) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgRevokeAuthorization) Type() string { return "revoke_authorization" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRevokeAuthorization) ValidateBasic() error {
+	if err := validateGrantees(msg.Granter, msg.Grantee); err != nil {
+		return err
+	}
+	if len(msg.MsgTypeURL) == 0 {
+		return sdkerrors.Wrap(ErrInvalidGrant, "missing message type url")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRevokeAuthorization) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRevokeAuthorization) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgExecAuthorized is a constructor function for MsgExecAuthorized
+func NewMsgExecAuthorized(grantee string, msgs []sdk.Msg) (*MsgExecAuthorized, error) {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+	return &MsgExecAuthorized{
+		Grantee: grantee,
+		Msgs:    anys,
+	}, nil
+}
+
+// GetMessages returns the messages to execute
+func (msg MsgExecAuthorized) GetMessages() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(msg.Msgs))
+	for i, any := range msg.Msgs {
+		m, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "message %d can't be unpacked", i)
+		}
+		msgs[i] = m
+	}
+	return msgs, nil
+}
+
+// Route Implements Msg
+func (msg MsgExecAuthorized) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgExecAuthorized) Type() string { return "exec_authorized" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgExecAuthorized) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Grantee); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address (%s)", err)
+	}
+	if len(msg.Msgs) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no message to execute")
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return err
+	}
+	for _, m := range msgs {
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgExecAuthorized) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgExecAuthorized) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgExecAuthorized) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, any := range msg.Msgs {
+		var m sdk.Msg
+		if err := unpacker.UnpackAny(any, &m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateGrantees checks the addresses of a grant, an account can't grant
+// itself
+func validateGrantees(granter, grantee string) error {
+	granterAddr, err := sdk.AccAddressFromBech32(granter)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid granter address (%s)", err)
+	}
+	granteeAddr, err := sdk.AccAddressFromBech32(grantee)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address (%s)", err)
+	}
+	if granterAddr.Equals(granteeAddr) {
+		return sdkerrors.Wrap(ErrInvalidGrant, "granter and grantee can't be the same")
+	}
+	return nil
 }
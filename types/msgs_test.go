@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -15,39 +16,39 @@ func TestNewMsgTransferNFT(t *testing.T) {
 	newMsgTransferNFT := types.NewMsgTransferNFT(
 		fmt.Sprintf("     %s     ", denomID),
 		fmt.Sprintf("     %s     ", denom),
-		fmt.Sprintf("     %s     ", id), tokenURI, tokenData, address, address2)
-	require.Equal(t, newMsgTransferNFT.Sender, address)
-	require.Equal(t, newMsgTransferNFT.Recipient, address2)
+		fmt.Sprintf("     %s     ", id), tokenURI, tokenURIHash, tokenData, address.String(), address2.String())
+	require.Equal(t, newMsgTransferNFT.Sender, address.String())
+	require.Equal(t, newMsgTransferNFT.Recipient, address2.String())
 	require.Equal(t, newMsgTransferNFT.Denom, denom)
 	require.Equal(t, newMsgTransferNFT.Id, denomID)
 }
 
 func TestMsgTransferNFTValidateBasicMethod(t *testing.T) {
-	newMsgTransferNFT := types.NewMsgTransferNFT(denomID, "", id, tokenURI, tokenData, address, address2)
+	newMsgTransferNFT := types.NewMsgTransferNFT(denomID, "", id, tokenURI, tokenURIHash, tokenData, address.String(), address2.String())
 	err := newMsgTransferNFT.ValidateBasic()
 	require.Error(t, err)
 
-	newMsgTransferNFT = types.NewMsgTransferNFT(denomID, denom, "", tokenURI, tokenData, nil, address2)
+	newMsgTransferNFT = types.NewMsgTransferNFT(denomID, denom, "", tokenURI, tokenURIHash, tokenData, "", address2.String())
 	err = newMsgTransferNFT.ValidateBasic()
 	require.Error(t, err)
 
-	newMsgTransferNFT = types.NewMsgTransferNFT(denomID, denom, "", tokenURI, tokenData, address, nil)
+	newMsgTransferNFT = types.NewMsgTransferNFT(denomID, denom, "", tokenURI, tokenURIHash, tokenData, address.String(), "")
 	err = newMsgTransferNFT.ValidateBasic()
 	require.Error(t, err)
 
-	newMsgTransferNFT = types.NewMsgTransferNFT(denomID, denom, id, tokenURI, tokenData, address, address2)
+	newMsgTransferNFT = types.NewMsgTransferNFT(denomID, denom, id, tokenURI, tokenURIHash, tokenData, address.String(), address2.String())
 	err = newMsgTransferNFT.ValidateBasic()
 	require.NoError(t, err)
 }
 
 func TestMsgTransferNFTGetSignBytesMethod(t *testing.T) {
-	newMsgTransferNFT := types.NewMsgTransferNFT(denomID, denom, id, tokenURI, tokenData, address, address2)
+	newMsgTransferNFT := types.NewMsgTransferNFT(denomID, denom, id, tokenURI, tokenURIHash, tokenData, address.String(), address2.String())
 	sortedBytes := newMsgTransferNFT.GetSignBytes()
-	require.Equal(t, string(sortedBytes), `{"type":"irismod/nft/MsgTransferNFT","value":{"data":"https://google.com/token-1.json","denom":"denom","id":"denom","name":"id1","recipient":"cosmos15ky9du8a2wlstz6fpx3p4mqpjyrm5cgp0ctjdj","sender":"cosmos15ky9du8a2wlstz6fpx3p4mqpjyrm5cgqjwl8sq","uri":"https://google.com/token-1.json"}}`)
+	require.Equal(t, string(sortedBytes), `{"type":"irismod/nft/MsgTransferNFT","value":{"data":"https://google.com/token-1.json","denom":"denom","id":"denom","name":"id1","recipient":"cosmos15ky9du8a2wlstz6fpx3p4mqpjyrm5cgp0ctjdj","sender":"cosmos15ky9du8a2wlstz6fpx3p4mqpjyrm5cgqjwl8sq","uri":"https://google.com/token-1.json","uri_hash":"a591a6d40bf420404a011733cfb7b190d62c65bf0bcda32b57b277d9ad9f146e"}}`)
 }
 
 func TestMsgTransferNFTGetSignersMethod(t *testing.T) {
-	newMsgTransferNFT := types.NewMsgTransferNFT(denomID, denom, id, tokenURI, tokenData, address, address2)
+	newMsgTransferNFT := types.NewMsgTransferNFT(denomID, denom, id, tokenURI, tokenURIHash, tokenData, address.String(), address2.String())
 	signers := newMsgTransferNFT.GetSigners()
 	require.Equal(t, 1, len(signers))
 	require.Equal(t, address.String(), signers[0].String())
@@ -58,41 +59,52 @@ func TestNewMsgEditNFT(t *testing.T) {
 		fmt.Sprintf("     %s     ", id),
 		fmt.Sprintf("     %s     ", denom),
 		fmt.Sprintf("     %s     ", nftName),
-		fmt.Sprintf("     %s     ", tokenURI), tokenData, address)
+		fmt.Sprintf("     %s     ", tokenURI),
+		fmt.Sprintf("     %s     ", strings.ToUpper(tokenURIHash)), tokenData, attributes, address.String())
 
-	require.Equal(t, newMsgEditNFT.Sender.String(), address.String())
+	require.Equal(t, newMsgEditNFT.Sender, address.String())
 	require.Equal(t, newMsgEditNFT.Id, id)
 	require.Equal(t, newMsgEditNFT.Denom, denom)
 	require.Equal(t, newMsgEditNFT.URI, tokenURI)
+	require.Equal(t, newMsgEditNFT.URIHash, tokenURIHash)
 }
 
 func TestMsgEditNFTValidateBasicMethod(t *testing.T) {
-	newMsgEditNFT := types.NewMsgEditNFT(id, denom, nftName, tokenURI, tokenData, nil)
+	newMsgEditNFT := types.NewMsgEditNFT(id, denom, nftName, tokenURI, tokenURIHash, tokenData, attributes, "")
 
 	err := newMsgEditNFT.ValidateBasic()
 	require.Error(t, err)
 
-	newMsgEditNFT = types.NewMsgEditNFT("", denom, nftName, tokenURI, tokenData, address)
+	newMsgEditNFT = types.NewMsgEditNFT("", denom, nftName, tokenURI, tokenURIHash, tokenData, attributes, address.String())
 	err = newMsgEditNFT.ValidateBasic()
 	require.Error(t, err)
 
-	newMsgEditNFT = types.NewMsgEditNFT(id, "", nftName, tokenURI, tokenData, address)
+	newMsgEditNFT = types.NewMsgEditNFT(id, "", nftName, tokenURI, tokenURIHash, tokenData, attributes, address.String())
 	err = newMsgEditNFT.ValidateBasic()
 	require.Error(t, err)
 
-	newMsgEditNFT = types.NewMsgEditNFT(id, denom, nftName, tokenURI, tokenData, address)
+	newMsgEditNFT = types.NewMsgEditNFT(id, denom, nftName, tokenURI, tokenURIHash, tokenData, attributes, address.String())
+	err = newMsgEditNFT.ValidateBasic()
+	require.NoError(t, err)
+
+	// the attributes can't be set while clearing them
+	newMsgEditNFT.ClearAttributes = true
+	err = newMsgEditNFT.ValidateBasic()
+	require.Error(t, err)
+
+	newMsgEditNFT.Attributes = nil
 	err = newMsgEditNFT.ValidateBasic()
 	require.NoError(t, err)
 }
 
 func TestMsgEditNFTGetSignBytesMethod(t *testing.T) {
-	newMsgEditNFT := types.NewMsgEditNFT(id, denom, nftName, tokenURI, tokenData, address)
+	newMsgEditNFT := types.NewMsgEditNFT(id, denom, nftName, tokenURI, tokenURIHash, tokenData, attributes, address.String())
 	sortedBytes := newMsgEditNFT.GetSignBytes()
-	require.Equal(t, string(sortedBytes), `{"type":"irismod/nft/MsgEditNFT","value":{"data":"https://google.com/token-1.json","denom":"denom","id":"id1","name":"report","sender":"cosmos15ky9du8a2wlstz6fpx3p4mqpjyrm5cgqjwl8sq","uri":"https://google.com/token-1.json"}}`)
+	require.Equal(t, string(sortedBytes), `{"type":"irismod/nft/MsgEditNFT","value":{"attributes":[{"key":"rarity","type":"string","value":"legendary"}],"data":"https://google.com/token-1.json","denom":"denom","id":"id1","name":"report","sender":"cosmos15ky9du8a2wlstz6fpx3p4mqpjyrm5cgqjwl8sq","uri":"https://google.com/token-1.json","uri_hash":"a591a6d40bf420404a011733cfb7b190d62c65bf0bcda32b57b277d9ad9f146e"}}`)
 }
 
 func TestMsgEditNFTGetSignersMethod(t *testing.T) {
-	newMsgEditNFT := types.NewMsgEditNFT(id, denom, nftName, tokenURI, tokenData, address)
+	newMsgEditNFT := types.NewMsgEditNFT(id, denom, nftName, tokenURI, tokenURIHash, tokenData, attributes, address.String())
 	signers := newMsgEditNFT.GetSigners()
 	require.Equal(t, 1, len(signers))
 	require.Equal(t, address.String(), signers[0].String())
@@ -103,76 +115,128 @@ func TestNewMsgMintNFT(t *testing.T) {
 		fmt.Sprintf("     %s     ", id),
 		fmt.Sprintf("     %s     ", denom),
 		fmt.Sprintf("     %s     ", nftName),
-		fmt.Sprintf("     %s     ", tokenURI), tokenData, address, address2)
+		fmt.Sprintf("     %s     ", tokenURI),
+		fmt.Sprintf("     %s     ", tokenURIHash), tokenData, attributes, address.String(), address2.String())
 
-	require.Equal(t, newMsgMintNFT.Sender.String(), address.String())
-	require.Equal(t, newMsgMintNFT.Recipient.String(), address2.String())
+	require.Equal(t, newMsgMintNFT.Sender, address.String())
+	require.Equal(t, newMsgMintNFT.Recipient, address2.String())
 	require.Equal(t, newMsgMintNFT.Id, id)
 	require.Equal(t, newMsgMintNFT.Denom, denom)
 	require.Equal(t, newMsgMintNFT.URI, tokenURI)
 }
 
 func TestMsgMsgMintNFTValidateBasicMethod(t *testing.T) {
-	newMsgMintNFT := types.NewMsgMintNFT(id, denom, nftName, tokenURI, tokenData, nil, address2)
+	newMsgMintNFT := types.NewMsgMintNFT(id, denom, nftName, tokenURI, tokenURIHash, tokenData, attributes, "", address2.String())
 	err := newMsgMintNFT.ValidateBasic()
 	require.Error(t, err)
 
-	newMsgMintNFT = types.NewMsgMintNFT("", denom, nftName, tokenURI, tokenData, address, address2)
+	newMsgMintNFT = types.NewMsgMintNFT("", denom, nftName, tokenURI, tokenURIHash, tokenData, attributes, address.String(), address2.String())
 	err = newMsgMintNFT.ValidateBasic()
 	require.Error(t, err)
 
-	newMsgMintNFT = types.NewMsgMintNFT(id, "", nftName, tokenURI, tokenData, address, address2)
+	newMsgMintNFT = types.NewMsgMintNFT(id, "", nftName, tokenURI, tokenURIHash, tokenData, attributes, address.String(), address2.String())
 	err = newMsgMintNFT.ValidateBasic()
 	require.Error(t, err)
 
-	newMsgMintNFT = types.NewMsgMintNFT(id, denom, nftName, tokenURI, tokenData, address, address2)
+	newMsgMintNFT = types.NewMsgMintNFT(id, denom, nftName, tokenURI, tokenURIHash, tokenData, attributes, address.String(), address2.String())
 	err = newMsgMintNFT.ValidateBasic()
 	require.NoError(t, err)
 }
 
 func TestMsgMintNFTGetSignBytesMethod(t *testing.T) {
-	newMsgMintNFT := types.NewMsgMintNFT(id, denom, nftName, tokenURI, tokenData, address, address2)
+	newMsgMintNFT := types.NewMsgMintNFT(id, denom, nftName, tokenURI, tokenURIHash, tokenData, attributes, address.String(), address2.String())
 	sortedBytes := newMsgMintNFT.GetSignBytes()
-	require.Equal(t, string(sortedBytes), `{"type":"irismod/nft/MsgMintNFT","value":{"data":"https://google.com/token-1.json","denom":"denom","id":"id1","name":"report","recipient":"cosmos15ky9du8a2wlstz6fpx3p4mqpjyrm5cgp0ctjdj","sender":"cosmos15ky9du8a2wlstz6fpx3p4mqpjyrm5cgqjwl8sq","uri":"https://google.com/token-1.json"}}`)
+	require.Equal(t, string(sortedBytes), `{"type":"irismod/nft/MsgMintNFT","value":{"attributes":[{"key":"rarity","type":"string","value":"legendary"}],"data":"https://google.com/token-1.json","denom":"denom","id":"id1","name":"report","recipient":"cosmos15ky9du8a2wlstz6fpx3p4mqpjyrm5cgp0ctjdj","sender":"cosmos15ky9du8a2wlstz6fpx3p4mqpjyrm5cgqjwl8sq","uri":"https://google.com/token-1.json","uri_hash":"a591a6d40bf420404a011733cfb7b190d62c65bf0bcda32b57b277d9ad9f146e"}}`)
 }
 
 func TestNewMsgBurnNFT(t *testing.T) {
-	newMsgBurnNFT := types.NewMsgBurnNFT(address,
+	newMsgBurnNFT := types.NewMsgBurnNFT(address.String(),
 		fmt.Sprintf("     %s     ", id),
 		fmt.Sprintf("     %s     ", denom))
 
-	require.Equal(t, newMsgBurnNFT.Sender.String(), address.String())
+	require.Equal(t, newMsgBurnNFT.Sender, address.String())
 	require.Equal(t, newMsgBurnNFT.Id, id)
 	require.Equal(t, newMsgBurnNFT.Denom, denom)
 }
 
 func TestMsgMsgBurnNFTValidateBasicMethod(t *testing.T) {
-	newMsgBurnNFT := types.NewMsgBurnNFT(nil, id, denom)
+	newMsgBurnNFT := types.NewMsgBurnNFT("", id, denom)
 	err := newMsgBurnNFT.ValidateBasic()
 	require.Error(t, err)
 
-	newMsgBurnNFT = types.NewMsgBurnNFT(address, "", denom)
+	newMsgBurnNFT = types.NewMsgBurnNFT(address.String(), "", denom)
 	err = newMsgBurnNFT.ValidateBasic()
 	require.Error(t, err)
 
-	newMsgBurnNFT = types.NewMsgBurnNFT(address, id, "")
+	newMsgBurnNFT = types.NewMsgBurnNFT(address.String(), id, "")
 	err = newMsgBurnNFT.ValidateBasic()
 	require.Error(t, err)
 
-	newMsgBurnNFT = types.NewMsgBurnNFT(address, id, denom)
+	newMsgBurnNFT = types.NewMsgBurnNFT(address.String(), id, denom)
 	err = newMsgBurnNFT.ValidateBasic()
 	require.NoError(t, err)
 }
 
 func TestMsgBurnNFTGetSignBytesMethod(t *testing.T) {
-	newMsgBurnNFT := types.NewMsgBurnNFT(address, id, denom)
+	newMsgBurnNFT := types.NewMsgBurnNFT(address.String(), id, denom)
 	sortedBytes := newMsgBurnNFT.GetSignBytes()
 	require.Equal(t, string(sortedBytes), `{"type":"irismod/nft/MsgBurnNFT","value":{"denom":"denom","id":"id1","sender":"cosmos15ky9du8a2wlstz6fpx3p4mqpjyrm5cgqjwl8sq"}}`)
 }
 
 func TestMsgBurnNFTGetSignersMethod(t *testing.T) {
-	newMsgBurnNFT := types.NewMsgBurnNFT(address, id, denom)
+	newMsgBurnNFT := types.NewMsgBurnNFT(address.String(), id, denom)
 	signers := newMsgBurnNFT.GetSigners()
 	require.Equal(t, 1, len(signers))
 	require.Equal(t, address.String(), signers[0].String())
 }
+
+func TestMsgPauseDenomValidateBasicMethod(t *testing.T) {
+	require.Error(t, types.NewMsgPauseDenom(denom, "").ValidateBasic())
+	require.Error(t, types.NewMsgPauseDenom("", address.String()).ValidateBasic())
+	require.NoError(t, types.NewMsgPauseDenom(fmt.Sprintf("  %s  ", denom), address.String()).ValidateBasic())
+
+	require.Error(t, types.NewMsgUnpauseDenom(denom, "").ValidateBasic())
+	require.NoError(t, types.NewMsgUnpauseDenom(denom, address.String()).ValidateBasic())
+}
+
+func TestMsgPauseDenomGetSignBytesMethod(t *testing.T) {
+	sortedBytes := types.NewMsgPauseDenom(denom, address.String()).GetSignBytes()
+	require.Equal(t, string(sortedBytes), `{"type":"irismod/nft/MsgPauseDenom","value":{"denom":"denom","sender":"cosmos15ky9du8a2wlstz6fpx3p4mqpjyrm5cgqjwl8sq"}}`)
+
+	sortedBytes = types.NewMsgUnpauseDenom(denom, address.String()).GetSignBytes()
+	require.Equal(t, string(sortedBytes), `{"type":"irismod/nft/MsgUnpauseDenom","value":{"denom":"denom","sender":"cosmos15ky9du8a2wlstz6fpx3p4mqpjyrm5cgqjwl8sq"}}`)
+}
+
+func TestMsgUpdatePolicyListValidateBasicMethod(t *testing.T) {
+	require.NoError(t, types.NewMsgUpdatePolicyList(denom, types.ListAllow, []string{address2.String()}, nil, address.String()).ValidateBasic())
+	require.NoError(t, types.NewMsgUpdatePolicyList(denom, " Deny ", nil, []string{address2.String()}, address.String()).ValidateBasic())
+	require.Error(t, types.NewMsgUpdatePolicyList(denom, "other", []string{address2.String()}, nil, address.String()).ValidateBasic())
+	require.Error(t, types.NewMsgUpdatePolicyList(denom, types.ListAllow, nil, nil, address.String()).ValidateBasic())
+	require.Error(t, types.NewMsgUpdatePolicyList(denom, types.ListAllow, []string{"invalid"}, nil, address.String()).ValidateBasic())
+	require.Error(t, types.NewMsgUpdatePolicyList(denom, types.ListAllow, []string{address2.String()}, []string{address2.String()}, address.String()).ValidateBasic())
+	require.Error(t, types.NewMsgUpdatePolicyList(denom, types.ListAllow, []string{address2.String()}, nil, "").ValidateBasic())
+}
+
+func TestMsgSetTransferPolicyValidateBasicMethod(t *testing.T) {
+	require.NoError(t, types.NewMsgSetTransferPolicy(denom, types.PolicyAllowList, address.String()).ValidateBasic())
+	require.NoError(t, types.NewMsgSetTransferPolicy(denom, "", address.String()).ValidateBasic())
+	require.Error(t, types.NewMsgSetTransferPolicy("", types.PolicyAllowList, address.String()).ValidateBasic())
+	require.Error(t, types.NewMsgSetTransferPolicy(denom, types.PolicyAllowList, "").ValidateBasic())
+}
+
+func TestMsgRevokeNFTValidateBasicMethod(t *testing.T) {
+	require.NoError(t, types.NewMsgRevokeNFT(id, denom, address.String(), "").ValidateBasic())
+	require.NoError(t, types.NewMsgRevokeNFT(id, denom, address.String(), address2.String()).ValidateBasic())
+	require.Error(t, types.NewMsgRevokeNFT(id, denom, address.String(), "invalid").ValidateBasic())
+	require.Error(t, types.NewMsgRevokeNFT(id, denom, "", "").ValidateBasic())
+	require.Error(t, types.NewMsgRevokeNFT("", denom, address.String(), "").ValidateBasic())
+	require.Error(t, types.NewMsgRevokeNFT(id, "", address.String(), "").ValidateBasic())
+}
+
+func TestMsgSetMetadataTemplateValidateBasicMethod(t *testing.T) {
+	require.NoError(t, types.NewMsgSetMetadataTemplate(denom, nil, address.String()).ValidateBasic())
+	require.NoError(t, types.NewMsgSetMetadataTemplate(denom, &types.MetadataTemplate{Image: "/image"}, address.String()).ValidateBasic())
+	require.Error(t, types.NewMsgSetMetadataTemplate(denom, &types.MetadataTemplate{Image: "image"}, address.String()).ValidateBasic())
+	require.Error(t, types.NewMsgSetMetadataTemplate("", nil, address.String()).ValidateBasic())
+	require.Error(t, types.NewMsgSetMetadataTemplate(denom, nil, "").ValidateBasic())
+}
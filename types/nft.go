@@ -12,13 +12,15 @@ import (
 var _ exported.NFT = BaseNFT{}
 
 // NewBaseNFT creates a new NFT instance
-func NewBaseNFT(id, name string, owner sdk.AccAddress, tokenURI, tokenData string) BaseNFT {
+func NewBaseNFT(id, name string, owner sdk.AccAddress, tokenURI, tokenURIHash, tokenData string, attributes []Attribute) BaseNFT {
 	return BaseNFT{
-		Id:    strings.ToLower(strings.TrimSpace(id)),
-		Name:  strings.TrimSpace(name),
-		Owner: owner,
-		URI:   strings.TrimSpace(tokenURI),
-		Data:  strings.TrimSpace(tokenData),
+		Id:         strings.ToLower(strings.TrimSpace(id)),
+		Name:       strings.TrimSpace(name),
+		Owner:      owner,
+		URI:        strings.TrimSpace(tokenURI),
+		URIHash:    strings.ToLower(strings.TrimSpace(tokenURIHash)),
+		Data:       strings.TrimSpace(tokenData),
+		Attributes: attributes,
 	}
 }
 
@@ -38,6 +40,14 @@ func (bnft BaseNFT) GetURI() string {
 	return bnft.URI
 }
 
+func (bnft BaseNFT) GetURIHash() string {
+	return bnft.URIHash
+}
+
+func (bnft BaseNFT) GetAttributes() []Attribute {
+	return bnft.Attributes
+}
+
 func (bnft BaseNFT) GetData() string {
 	return bnft.Data
 }
@@ -67,9 +77,15 @@ func ValidateTokenID(tokenID string) error {
 	return nil
 }
 
+// ValidateTokenURI checks the uri of a token, either absolute or relative to
+// the base uri of its denom. A relative uri only accepts the {id} placeholder,
+// the braces of the absolute ones such as the data uris are kept as is.
 func ValidateTokenURI(tokenURI string) error {
 	if len(tokenURI) > MaxTokenURILen {
 		return sdkerrors.Wrapf(ErrInvalidTokenURI, "invalid tokenURI %s, only accepts value [0, %d]", tokenURI, MaxTokenURILen)
 	}
-	return nil
+	if IsAbsoluteURI(tokenURI) {
+		return nil
+	}
+	return validatePlaceholders(tokenURI)
 }
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys
+var (
+	KeyDepositPerByte = []byte("DepositPerByte")
+	KeyModulePaused   = []byte("Paused")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable returns the parameter key table of the nft module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(depositPerByte sdk.Coin, paused bool) Params {
+	return Params{
+		DepositPerByte: depositPerByte,
+		Paused:         paused,
+	}
+}
+
+// DefaultParams returns the default parameters of the nft module, no deposit
+// is required until governance sets a positive rate
+func DefaultParams() Params {
+	return NewParams(sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()), false)
+}
+
+// ParamSetPairs implements paramtypes.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyDepositPerByte, &p.DepositPerByte, validateDepositPerByte),
+		paramtypes.NewParamSetPair(KeyModulePaused, &p.Paused, validatePaused),
+	}
+}
+
+// Validate validates the parameters
+func (p Params) Validate() error {
+	if err := validateDepositPerByte(p.DepositPerByte); err != nil {
+		return err
+	}
+	return validatePaused(p.Paused)
+}
+
+// String implements fmt.Stringer
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// Deposit returns the deposit required to store the given number of bytes
+func (p Params) Deposit(size int) sdk.Coin {
+	return sdk.NewCoin(p.DepositPerByte.Denom, p.DepositPerByte.Amount.MulRaw(int64(size)))
+}
+
+func validateDepositPerByte(i interface{}) error {
+	coin, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if !coin.IsValid() {
+		return fmt.Errorf("invalid deposit per byte: %s", coin)
+	}
+	return nil
+}
+
+func validatePaused(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// PolicyAllowList restricts the recipients to the allow list of the denom
+	PolicyAllowList = "allowlist"
+	// PolicyDenyList blocks the senders and recipients of the deny list of the denom
+	PolicyDenyList = "denylist"
+
+	// ListAllow is the name of the allow list of a denom
+	ListAllow = "allow"
+	// ListDeny is the name of the deny list of a denom
+	ListDeny = "deny"
+)
+
+// PolicyLists gives the transfer policies read access to the policy lists
+// managed by the denom creators
+type PolicyLists interface {
+	IsListed(ctx sdk.Context, denomID, list string, address sdk.AccAddress) bool
+}
+
+// TransferPolicy restricts the mints and transfers of the NFTs of the denoms
+// using it. The sender of a mint is the minter.
+type TransferPolicy interface {
+	// Name is the name selecting the policy in MsgSetTransferPolicy
+	Name() string
+	// CheckTransfer returns an error if the NFT can't move from the sender to the recipient
+	CheckTransfer(ctx sdk.Context, lists PolicyLists, denomID, tokenID string, sender, recipient sdk.AccAddress) error
+}
+
+var (
+	_ TransferPolicy = AllowListPolicy{}
+	_ TransferPolicy = DenyListPolicy{}
+)
+
+// IsBuiltinPolicy returns whether the transfer policy is registered by every
+// keeper
+func IsBuiltinPolicy(name string) bool {
+	return name == PolicyAllowList || name == PolicyDenyList
+}
+
+// AllowListPolicy only accepts the recipients of the allow list of the denom
+type AllowListPolicy struct{}
+
+// Name implements TransferPolicy
+func (AllowListPolicy) Name() string { return PolicyAllowList }
+
+// CheckTransfer implements TransferPolicy
+func (AllowListPolicy) CheckTransfer(ctx sdk.Context, lists PolicyLists, denomID, _ string, _, recipient sdk.AccAddress) error {
+	if !lists.IsListed(ctx, denomID, ListAllow, recipient) {
+		return sdkerrors.Wrapf(ErrTransferRestricted, "recipient %s is not allowed by denom %s", recipient, denomID)
+	}
+	return nil
+}
+
+// DenyListPolicy rejects the senders and recipients of the deny list of the denom
+type DenyListPolicy struct{}
+
+// Name implements TransferPolicy
+func (DenyListPolicy) Name() string { return PolicyDenyList }
+
+// CheckTransfer implements TransferPolicy
+func (DenyListPolicy) CheckTransfer(ctx sdk.Context, lists PolicyLists, denomID, _ string, sender, recipient sdk.AccAddress) error {
+	for _, address := range []sdk.AccAddress{sender, recipient} {
+		if lists.IsListed(ctx, denomID, ListDeny, address) {
+			return sdkerrors.Wrapf(ErrTransferRestricted, "address %s is denied by denom %s", address, denomID)
+		}
+	}
+	return nil
+}
+
+// ValidatePolicyList checks the name of a policy list
+func ValidatePolicyList(list string) error {
+	if list != ListAllow && list != ListDeny {
+		return sdkerrors.Wrapf(ErrInvalidPolicy, "invalid policy list %s, expected %s or %s", list, ListAllow, ListDeny)
+	}
+	return nil
+}
+
+// ValidatePolicyAddresses checks the addresses added to or removed from a policy list
+func ValidatePolicyAddresses(addresses []string) error {
+	seen := make(map[string]bool, len(addresses))
+	for _, address := range addresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid policy address %s (%s)", address, err)
+		}
+		if seen[address] {
+			return sdkerrors.Wrap(ErrInvalidPolicy, fmt.Sprintf("duplicated policy address %s", address))
+		}
+		seen[address] = true
+	}
+	return nil
+}
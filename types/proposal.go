@@ -0,0 +1,173 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeForceBurn defines the type of a ForceBurnProposal
+	ProposalTypeForceBurn = "ForceBurn"
+	// ProposalTypeHide defines the type of a HideProposal
+	ProposalTypeHide = "Hide"
+	// ProposalTypeReassignDenomCreator defines the type of a ReassignDenomCreatorProposal
+	ProposalTypeReassignDenomCreator = "ReassignDenomCreator"
+)
+
+var (
+	_ govtypes.Content = &ForceBurnProposal{}
+	_ govtypes.Content = &HideProposal{}
+	_ govtypes.Content = &ReassignDenomCreatorProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeForceBurn)
+	govtypes.RegisterProposalTypeCodec(&ForceBurnProposal{}, "irismod/nft/ForceBurnProposal")
+	govtypes.RegisterProposalType(ProposalTypeHide)
+	govtypes.RegisterProposalTypeCodec(&HideProposal{}, "irismod/nft/HideProposal")
+	govtypes.RegisterProposalType(ProposalTypeReassignDenomCreator)
+	govtypes.RegisterProposalTypeCodec(&ReassignDenomCreatorProposal{}, "irismod/nft/ReassignDenomCreatorProposal")
+}
+
+// NewForceBurnProposal creates a new ForceBurnProposal
+func NewForceBurnProposal(title, description, denomID, tokenID string) *ForceBurnProposal {
+	return &ForceBurnProposal{
+		Title:       title,
+		Description: description,
+		DenomId:     strings.ToLower(strings.TrimSpace(denomID)),
+		TokenId:     strings.ToLower(strings.TrimSpace(tokenID)),
+	}
+}
+
+// GetTitle implements govtypes.Content
+func (p *ForceBurnProposal) GetTitle() string { return p.Title }
+
+// GetDescription implements govtypes.Content
+func (p *ForceBurnProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute implements govtypes.Content
+func (p *ForceBurnProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType implements govtypes.Content
+func (p *ForceBurnProposal) ProposalType() string { return ProposalTypeForceBurn }
+
+// ValidateBasic implements govtypes.Content
+func (p *ForceBurnProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := ValidateDenomID(p.DenomId); err != nil {
+		return err
+	}
+	return ValidateTokenID(p.TokenId)
+}
+
+// String implements fmt.Stringer
+func (p ForceBurnProposal) String() string {
+	return fmt.Sprintf(`Force Burn Proposal:
+  Title:       %s
+  Description: %s
+  Denom:       %s
+  Token:       %s
+`, p.Title, p.Description, p.DenomId, p.TokenId)
+}
+
+// NewHideProposal creates a new HideProposal, an empty tokenID targets the denom
+func NewHideProposal(title, description, denomID, tokenID string, hidden bool) *HideProposal {
+	return &HideProposal{
+		Title:       title,
+		Description: description,
+		DenomId:     strings.ToLower(strings.TrimSpace(denomID)),
+		TokenId:     strings.ToLower(strings.TrimSpace(tokenID)),
+		Hidden:      hidden,
+	}
+}
+
+// GetTitle implements govtypes.Content
+func (p *HideProposal) GetTitle() string { return p.Title }
+
+// GetDescription implements govtypes.Content
+func (p *HideProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute implements govtypes.Content
+func (p *HideProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType implements govtypes.Content
+func (p *HideProposal) ProposalType() string { return ProposalTypeHide }
+
+// ValidateBasic implements govtypes.Content
+func (p *HideProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := ValidateDenomID(p.DenomId); err != nil {
+		return err
+	}
+	if len(p.TokenId) == 0 {
+		return nil
+	}
+	return ValidateTokenID(p.TokenId)
+}
+
+// String implements fmt.Stringer
+func (p HideProposal) String() string {
+	return fmt.Sprintf(`Hide Proposal:
+  Title:       %s
+  Description: %s
+  Denom:       %s
+  Token:       %s
+  Hidden:      %t
+`, p.Title, p.Description, p.DenomId, p.TokenId, p.Hidden)
+}
+
+// NewReassignDenomCreatorProposal creates a new ReassignDenomCreatorProposal
+func NewReassignDenomCreatorProposal(title, description, denomID, creator string) *ReassignDenomCreatorProposal {
+	return &ReassignDenomCreatorProposal{
+		Title:       title,
+		Description: description,
+		DenomId:     strings.ToLower(strings.TrimSpace(denomID)),
+		Creator:     creator,
+	}
+}
+
+// GetTitle implements govtypes.Content
+func (p *ReassignDenomCreatorProposal) GetTitle() string { return p.Title }
+
+// GetDescription implements govtypes.Content
+func (p *ReassignDenomCreatorProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute implements govtypes.Content
+func (p *ReassignDenomCreatorProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType implements govtypes.Content
+func (p *ReassignDenomCreatorProposal) ProposalType() string {
+	return ProposalTypeReassignDenomCreator
+}
+
+// ValidateBasic implements govtypes.Content
+func (p *ReassignDenomCreatorProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := ValidateDenomID(p.DenomId); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (p ReassignDenomCreatorProposal) String() string {
+	return fmt.Sprintf(`Reassign Denom Creator Proposal:
+  Title:       %s
+  Description: %s
+  Denom:       %s
+  Creator:     %s
+`, p.Title, p.Description, p.DenomId, p.Creator)
+}
@@ -0,0 +1,33 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/irismod/nft/types"
+)
+
+func TestForceBurnProposalValidateBasic(t *testing.T) {
+	p := types.NewForceBurnProposal("title", "description", " Denom ", id)
+	require.NoError(t, p.ValidateBasic())
+	require.Equal(t, denom, p.DenomId)
+	require.Equal(t, types.RouterKey, p.ProposalRoute())
+	require.Equal(t, types.ProposalTypeForceBurn, p.ProposalType())
+
+	require.Error(t, types.NewForceBurnProposal("", "description", denom, id).ValidateBasic())
+	require.Error(t, types.NewForceBurnProposal("title", "description", denom, "").ValidateBasic())
+}
+
+func TestHideProposalValidateBasic(t *testing.T) {
+	require.NoError(t, types.NewHideProposal("title", "description", denom, id, true).ValidateBasic())
+	require.NoError(t, types.NewHideProposal("title", "description", denom, "", true).ValidateBasic())
+	require.Error(t, types.NewHideProposal("title", "description", "", id, true).ValidateBasic())
+	require.Error(t, types.NewHideProposal("title", "description", denom, "1d", false).ValidateBasic())
+}
+
+func TestReassignDenomCreatorProposalValidateBasic(t *testing.T) {
+	require.NoError(t, types.NewReassignDenomCreatorProposal("title", "description", denom, address.String()).ValidateBasic())
+	require.Error(t, types.NewReassignDenomCreatorProposal("title", "description", denom, "").ValidateBasic())
+	require.Error(t, types.NewReassignDenomCreatorProposal("title", "", denom, address.String()).ValidateBasic())
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"regexp"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TokenIDPlaceholder is replaced by the token id in the resolved token uris
+const TokenIDPlaceholder = "{id}"
+
+var (
+	// IsAbsoluteURI only accepts the uris starting with a scheme (RFC 3986)
+	IsAbsoluteURI = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+.\-]*:`).MatchString
+)
+
+// ResolveTokenURI returns the uri of the token against the base uri of its
+// denom. An empty uri resolves to the base uri followed by the token id, or to
+// the base uri itself when it holds the {id} placeholder, a relative one is
+// appended to the base uri. The absolute uris and the uris of the denoms
+// without base uri are kept. The {id} placeholders are replaced by the token
+// id.
+func ResolveTokenURI(baseURI, tokenID, tokenURI string) string {
+	template := tokenURI
+	switch {
+	case len(baseURI) == 0 || IsAbsoluteURI(tokenURI):
+	case len(tokenURI) == 0 && !strings.Contains(baseURI, TokenIDPlaceholder):
+		template = baseURI + TokenIDPlaceholder
+	default:
+		template = baseURI + tokenURI
+	}
+	return strings.ReplaceAll(template, TokenIDPlaceholder, tokenID)
+}
+
+// TokenURI returns the uri of the token resolved against the base uri of the denom
+func (d Denom) TokenURI(tokenID, tokenURI string) string {
+	return ResolveTokenURI(d.BaseURI, tokenID, tokenURI)
+}
+
+// ValidateBaseURI checks that the base uri is either empty or an absolute uri
+func ValidateBaseURI(baseURI string) error {
+	if len(baseURI) == 0 {
+		return nil
+	}
+	if len(baseURI) > MaxTokenURILen || !IsAbsoluteURI(baseURI) {
+		return sdkerrors.Wrapf(ErrInvalidTokenURI, "invalid base uri %s, only accepts an absolute uri of length [0, %d]", baseURI, MaxTokenURILen)
+	}
+	return validatePlaceholders(baseURI)
+}
+
+// validatePlaceholders checks that {id} is the only placeholder of the uri
+func validatePlaceholders(uri string) error {
+	if strings.ContainsAny(strings.ReplaceAll(uri, TokenIDPlaceholder, ""), "{}") {
+		return sdkerrors.Wrapf(ErrInvalidTokenURI, "invalid uri %s, only accepts the %s placeholder", uri, TokenIDPlaceholder)
+	}
+	return nil
+}
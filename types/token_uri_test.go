@@ -0,0 +1,55 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/irismod/nft/types"
+)
+
+func TestResolveTokenURI(t *testing.T) {
+	tests := []struct {
+		name     string
+		baseURI  string
+		tokenURI string
+		want     string
+	}{
+		{"no base uri", "", "kitty1.json", "kitty1.json"},
+		{"no base uri and empty uri", "", "", ""},
+		{"empty uri", "ipfs://cid/", "", "ipfs://cid/kitty1"},
+		{"empty uri with template", "ipfs://cid/{id}.json", "", "ipfs://cid/kitty1.json"},
+		{"relative uri", "ipfs://cid/", "tom.json", "ipfs://cid/tom.json"},
+		{"relative uri with template", "ipfs://cid/", "{id}/meta.json", "ipfs://cid/kitty1/meta.json"},
+		{"absolute uri", "ipfs://cid/", "https://example.com/tom.json", "https://example.com/tom.json"},
+		{"absolute uri with template", "ipfs://cid/", "https://example.com/{id}", "https://example.com/kitty1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, types.ResolveTokenURI(tt.baseURI, "kitty1", tt.tokenURI))
+		})
+	}
+}
+
+func TestValidateBaseURI(t *testing.T) {
+	require.NoError(t, types.ValidateBaseURI(""))
+	require.NoError(t, types.ValidateBaseURI("ipfs://cid/"))
+	require.NoError(t, types.ValidateBaseURI("https://example.com/{id}.json"))
+	require.Error(t, types.ValidateBaseURI("cid/"))
+	require.Error(t, types.ValidateBaseURI("ipfs://cid/{name}"))
+	require.Error(t, types.ValidateBaseURI("ipfs://"+strings.Repeat("a", types.MaxTokenURILen)))
+}
+
+func TestValidateTokenURIPlaceholders(t *testing.T) {
+	require.NoError(t, types.ValidateTokenURI(""))
+	require.NoError(t, types.ValidateTokenURI("{id}.json"))
+	require.NoError(t, types.ValidateTokenURI(types.DoNotModify))
+	require.Error(t, types.ValidateTokenURI("{id}/{name}.json"))
+	require.Error(t, types.ValidateTokenURI("{id"))
+
+	// the absolute uris aren't checked for placeholders
+	require.NoError(t, types.ValidateTokenURI(`data:application/json,{"name":"kitty"}`))
+	require.NoError(t, types.ValidateTokenURI("https://example.com/{name}.json"))
+}
@@ -0,0 +1,150 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// typedEventPrefix is the event type prefix shared by all the typed events of the module
+const typedEventPrefix = "irismod.nft."
+
+// EmitTypedEvent emits the proto message as an event whose type is the fully
+// qualified message name and whose attributes are its proto JSON encoded fields
+func EmitTypedEvent(ctx sdk.Context, tev proto.Message) error {
+	event, err := TypedEventToEvent(tev)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(event)
+	return nil
+}
+
+// TypedEventToEvent converts the proto message to an sdk.Event, the attributes
+// are sorted by key so the encoding is deterministic
+func TypedEventToEvent(tev proto.Message) (sdk.Event, error) {
+	evtJSON, err := codec.ProtoMarshalJSON(tev)
+	if err != nil {
+		return sdk.Event{}, err
+	}
+
+	var attrMap map[string]json.RawMessage
+	if err := json.Unmarshal(evtJSON, &attrMap); err != nil {
+		return sdk.Event{}, err
+	}
+
+	keys := make([]string, 0, len(attrMap))
+	for k := range attrMap {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	attrs := make([]abci.EventAttribute, 0, len(keys))
+	for _, k := range keys {
+		attrs = append(attrs, abci.EventAttribute{
+			Key:   []byte(k),
+			Value: attrMap[k],
+		})
+	}
+
+	return sdk.Event{
+		Type:       proto.MessageName(tev),
+		Attributes: attrs,
+	}, nil
+}
+
+// ParseTypedEvent converts an abci.Event emitted by EmitTypedEvent back into
+// the proto message it was built from
+func ParseTypedEvent(event abci.Event) (proto.Message, error) {
+	concreteGoType := proto.MessageType(event.Type)
+	if concreteGoType == nil {
+		return nil, fmt.Errorf("failed to retrieve the message of type %q", event.Type)
+	}
+
+	var value reflect.Value
+	if concreteGoType.Kind() == reflect.Ptr {
+		value = reflect.New(concreteGoType.Elem())
+	} else {
+		value = reflect.Zero(concreteGoType)
+	}
+
+	protoMsg, ok := value.Interface().(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("%q does not implement proto.Message", event.Type)
+	}
+
+	attrMap := make(map[string]json.RawMessage, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		attrMap[string(attr.Key)] = attr.Value
+	}
+
+	attrBytes, err := json.Marshal(attrMap)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := jsonpb.Unmarshal(bytes.NewReader(attrBytes), protoMsg); err != nil {
+		return nil, err
+	}
+	return protoMsg, nil
+}
+
+// ParseTypedEvents decodes the typed events of the nft module, all the other
+// events (including the legacy string events) are skipped
+func ParseTypedEvents(events []abci.Event) ([]proto.Message, error) {
+	var msgs []proto.Message
+	for _, event := range events {
+		if !strings.HasPrefix(event.Type, typedEventPrefix) {
+			continue
+		}
+
+		msg, err := ParseTypedEvent(event)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
+
+// NFTChanges returns the fields of the NFT that differ between before and after,
+// the owner is left out as it is reported by EventTransfer itself
+func NFTChanges(before, after BaseNFT) []FieldChange {
+	var changes []FieldChange
+	appendChange := func(field, oldValue, newValue string) {
+		if oldValue != newValue {
+			changes = append(changes, FieldChange{
+				Field:    field,
+				OldValue: oldValue,
+				NewValue: newValue,
+			})
+		}
+	}
+
+	appendChange("name", before.Name, after.Name)
+	appendChange("uri", before.URI, after.URI)
+	appendChange("uri_hash", before.URIHash, after.URIHash)
+	appendChange("data", before.Data, after.Data)
+	appendChange("attributes", attributesString(before.Attributes), attributesString(after.Attributes))
+	return changes
+}
+
+func attributesString(attributes []Attribute) string {
+	if len(attributes) == 0 {
+		return ""
+	}
+	bz, _ := json.Marshal(attributes)
+	return string(bz)
+}
@@ -0,0 +1,65 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irismod/nft/types"
+)
+
+func TestTypedEventRoundTrip(t *testing.T) {
+	events := []proto.Message{
+		&types.EventIssueDenom{DenomId: denomID, DenomName: nftName, Creator: address.String()},
+		&types.EventMint{DenomId: denomID, TokenId: id, TokenURI: tokenURI, Sender: address.String(), Recipient: address2.String()},
+		&types.EventTransfer{DenomId: denomID, TokenId: id, Sender: address.String(), Recipient: address2.String(), Changes: []types.FieldChange{}},
+		&types.EventEdit{DenomId: denomID, TokenId: id, Sender: address.String(), Changes: []types.FieldChange{
+			{Field: "uri", OldValue: tokenURI, NewValue: "ipfs://token"},
+		}},
+		&types.EventBurn{DenomId: denomID, TokenId: id, Owner: address.String()},
+	}
+
+	for _, tev := range events {
+		event, err := types.TypedEventToEvent(tev)
+		require.NoError(t, err)
+		require.Equal(t, proto.MessageName(tev), event.Type)
+
+		parsed, err := types.ParseTypedEvent(abci.Event(event))
+		require.NoError(t, err)
+		require.Equal(t, tev, parsed)
+	}
+}
+
+func TestParseTypedEvents(t *testing.T) {
+	mint, err := types.TypedEventToEvent(&types.EventMint{DenomId: denomID, TokenId: id})
+	require.NoError(t, err)
+
+	legacy := sdk.NewEvent(types.EventTypeMintNFT, sdk.NewAttribute(types.AttributeKeyTokenID, id))
+	msgs, err := types.ParseTypedEvents(sdk.Events{legacy, mint}.ToABCIEvents())
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	require.Equal(t, id, msgs[0].(*types.EventMint).TokenId)
+
+	_, err = types.ParseTypedEvent(abci.Event{Type: "irismod.nft.EventUnknown"})
+	require.Error(t, err)
+}
+
+func TestNFTChanges(t *testing.T) {
+	before := types.NewBaseNFT(id, nftName, address, tokenURI, tokenURIHash, tokenData, nil)
+	after := before
+	after.URI = "ipfs://token"
+	after.Attributes = attributes
+
+	changes := types.NFTChanges(before, after)
+	require.Len(t, changes, 2)
+	require.Equal(t, types.FieldChange{Field: "uri", OldValue: tokenURI, NewValue: "ipfs://token"}, changes[0])
+	require.Equal(t, "attributes", changes[1].Field)
+	require.Empty(t, changes[1].OldValue)
+
+	require.Empty(t, types.NFTChanges(before, before))
+}
@@ -7,6 +7,7 @@ import (
 	bytes "bytes"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -25,26 +26,67 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// MsgIssueDenom defines an SDK message for creating a new denom.
-type MsgIssueDenom struct {
-	Id     string                                        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name   string                                        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Schema string                                        `protobuf:"bytes,3,opt,name=schema,proto3" json:"schema,omitempty"`
-	Sender github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
+// HistoryAction defines the kind of a token history entry.
+type HistoryAction int32
+
+const (
+	HistoryActionUnspecified HistoryAction = 0
+	HistoryActionMint        HistoryAction = 1
+	HistoryActionTransfer    HistoryAction = 2
+	HistoryActionBurn        HistoryAction = 3
+	HistoryActionRevoke      HistoryAction = 4
+	HistoryActionForceBurn   HistoryAction = 5
+)
+
+var HistoryAction_name = map[int32]string{
+	0: "HISTORY_ACTION_UNSPECIFIED",
+	1: "HISTORY_ACTION_MINT",
+	2: "HISTORY_ACTION_TRANSFER",
+	3: "HISTORY_ACTION_BURN",
+	4: "HISTORY_ACTION_REVOKE",
+	5: "HISTORY_ACTION_FORCE_BURN",
+}
+
+var HistoryAction_value = map[string]int32{
+	"HISTORY_ACTION_UNSPECIFIED": 0,
+	"HISTORY_ACTION_MINT":        1,
+	"HISTORY_ACTION_TRANSFER":    2,
+	"HISTORY_ACTION_BURN":        3,
+	"HISTORY_ACTION_REVOKE":      4,
+	"HISTORY_ACTION_FORCE_BURN":  5,
+}
+
+func (x HistoryAction) String() string {
+	return proto.EnumName(HistoryAction_name, int32(x))
+}
+
+func (HistoryAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{0}
+}
+
+// BaseNFT defines a non fungible token.
+type BaseNFT struct {
+	Id         string                                        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string                                        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	URI        string                                        `protobuf:"bytes,3,opt,name=uri,proto3" json:"uri,omitempty"`
+	Data       string                                        `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	Owner      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,5,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	URIHash    string                                        `protobuf:"bytes,6,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
+	Attributes []Attribute                                   `protobuf:"bytes,7,rep,name=attributes,proto3" json:"attributes"`
 }
 
-func (m *MsgIssueDenom) Reset()         { *m = MsgIssueDenom{} }
-func (m *MsgIssueDenom) String() string { return proto.CompactTextString(m) }
-func (*MsgIssueDenom) ProtoMessage()    {}
-func (*MsgIssueDenom) Descriptor() ([]byte, []int) {
+func (m *BaseNFT) Reset()         { *m = BaseNFT{} }
+func (m *BaseNFT) String() string { return proto.CompactTextString(m) }
+func (*BaseNFT) ProtoMessage()    {}
+func (*BaseNFT) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d938547f84707355, []int{0}
 }
-func (m *MsgIssueDenom) XXX_Unmarshal(b []byte) error {
+func (m *BaseNFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgIssueDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BaseNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgIssueDenom.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BaseNFT.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -54,41 +96,37 @@ func (m *MsgIssueDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *MsgIssueDenom) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgIssueDenom.Merge(m, src)
+func (m *BaseNFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseNFT.Merge(m, src)
 }
-func (m *MsgIssueDenom) XXX_Size() int {
+func (m *BaseNFT) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgIssueDenom) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgIssueDenom.DiscardUnknown(m)
+func (m *BaseNFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseNFT.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgIssueDenom proto.InternalMessageInfo
+var xxx_messageInfo_BaseNFT proto.InternalMessageInfo
 
-// MsgTransferNFT defines an SDK message for transferring an NFT to recipient.
-type MsgTransferNFT struct {
-	Id        string                                        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Denom     string                                        `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	Name      string                                        `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	URI       string                                        `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty"`
-	Data      string                                        `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
-	Sender    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,6,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
-	Recipient github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,7,opt,name=recipient,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"recipient,omitempty"`
+// Attribute defines a structured trait of a NFT, indexed per denom by key and value.
+type Attribute struct {
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Type  string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
 }
 
-func (m *MsgTransferNFT) Reset()         { *m = MsgTransferNFT{} }
-func (m *MsgTransferNFT) String() string { return proto.CompactTextString(m) }
-func (*MsgTransferNFT) ProtoMessage()    {}
-func (*MsgTransferNFT) Descriptor() ([]byte, []int) {
+func (m *Attribute) Reset()         { *m = Attribute{} }
+func (m *Attribute) String() string { return proto.CompactTextString(m) }
+func (*Attribute) ProtoMessage()    {}
+func (*Attribute) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d938547f84707355, []int{1}
 }
-func (m *MsgTransferNFT) XXX_Unmarshal(b []byte) error {
+func (m *Attribute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgTransferNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Attribute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgTransferNFT.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Attribute.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -98,40 +136,49 @@ func (m *MsgTransferNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *MsgTransferNFT) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgTransferNFT.Merge(m, src)
+func (m *Attribute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Attribute.Merge(m, src)
 }
-func (m *MsgTransferNFT) XXX_Size() int {
+func (m *Attribute) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgTransferNFT) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgTransferNFT.DiscardUnknown(m)
+func (m *Attribute) XXX_DiscardUnknown() {
+	xxx_messageInfo_Attribute.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgTransferNFT proto.InternalMessageInfo
+var xxx_messageInfo_Attribute proto.InternalMessageInfo
 
-// MsgEditNFT defines an SDK message for editing a nft.
-type MsgEditNFT struct {
-	Id     string                                        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Denom  string                                        `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	Name   string                                        `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	URI    string                                        `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty"`
-	Data   string                                        `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
-	Sender github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,6,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
+// Denom defines a type of NFT.
+type Denom struct {
+	Id      string                                        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name    string                                        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Schema  string                                        `protobuf:"bytes,3,opt,name=schema,proto3" json:"schema,omitempty"`
+	Creator github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=creator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"creator,omitempty"`
+	URI     string                                        `protobuf:"bytes,5,opt,name=uri,proto3" json:"uri,omitempty"`
+	URIHash string                                        `protobuf:"bytes,6,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
+	// history_retention is the number of history entries kept per token
+	HistoryRetention uint64 `protobuf:"varint,7,opt,name=history_retention,json=historyRetention,proto3" json:"history_retention,omitempty"`
+	// revocable lets the creator revoke the tokens of the denom held by others
+	Revocable bool `protobuf:"varint,8,opt,name=revocable,proto3" json:"revocable,omitempty"`
+	// metadata_template maps the data of the tokens to their metadata JSON,
+	// the default template is used when nil
+	MetadataTemplate *MetadataTemplate `protobuf:"bytes,9,opt,name=metadata_template,json=metadataTemplate,proto3" json:"metadata_template,omitempty"`
+	// base_uri resolves the empty and relative uris of the tokens of the denom
+	BaseURI string `protobuf:"bytes,10,opt,name=base_uri,json=baseUri,proto3" json:"base_uri,omitempty"`
 }
 
-func (m *MsgEditNFT) Reset()         { *m = MsgEditNFT{} }
-func (m *MsgEditNFT) String() string { return proto.CompactTextString(m) }
-func (*MsgEditNFT) ProtoMessage()    {}
-func (*MsgEditNFT) Descriptor() ([]byte, []int) {
+func (m *Denom) Reset()         { *m = Denom{} }
+func (m *Denom) String() string { return proto.CompactTextString(m) }
+func (*Denom) ProtoMessage()    {}
+func (*Denom) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d938547f84707355, []int{2}
 }
-func (m *MsgEditNFT) XXX_Unmarshal(b []byte) error {
+func (m *Denom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgEditNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Denom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgEditNFT.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Denom.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -141,41 +188,40 @@ func (m *MsgEditNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *MsgEditNFT) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgEditNFT.Merge(m, src)
+func (m *Denom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Denom.Merge(m, src)
 }
-func (m *MsgEditNFT) XXX_Size() int {
+func (m *Denom) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgEditNFT) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgEditNFT.DiscardUnknown(m)
+func (m *Denom) XXX_DiscardUnknown() {
+	xxx_messageInfo_Denom.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgEditNFT proto.InternalMessageInfo
+var xxx_messageInfo_Denom proto.InternalMessageInfo
 
-// MsgMintNFT defines an SDK message for creating a new NFT.
-type MsgMintNFT struct {
-	Id        string                                        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Denom     string                                        `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	Name      string                                        `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	URI       string                                        `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty"`
-	Data      string                                        `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
-	Sender    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,6,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
-	Recipient github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,7,opt,name=recipient,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"recipient,omitempty"`
+// MetadataTemplate defines the JSON pointers (RFC 6901) into the data of the
+// tokens the fields of their ERC-721 metadata are read from
+type MetadataTemplate struct {
+	Description  string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Image        string `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	ExternalURL  string `protobuf:"bytes,3,opt,name=external_url,json=externalUrl,proto3" json:"external_url,omitempty"`
+	AnimationURL string `protobuf:"bytes,4,opt,name=animation_url,json=animationUrl,proto3" json:"animation_url,omitempty"`
+	Attributes   string `protobuf:"bytes,5,opt,name=attributes,proto3" json:"attributes,omitempty"`
 }
 
-func (m *MsgMintNFT) Reset()         { *m = MsgMintNFT{} }
-func (m *MsgMintNFT) String() string { return proto.CompactTextString(m) }
-func (*MsgMintNFT) ProtoMessage()    {}
-func (*MsgMintNFT) Descriptor() ([]byte, []int) {
+func (m *MetadataTemplate) Reset()         { *m = MetadataTemplate{} }
+func (m *MetadataTemplate) String() string { return proto.CompactTextString(m) }
+func (*MetadataTemplate) ProtoMessage()    {}
+func (*MetadataTemplate) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d938547f84707355, []int{3}
 }
-func (m *MsgMintNFT) XXX_Unmarshal(b []byte) error {
+func (m *MetadataTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMintNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MetadataTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMintNFT.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MetadataTemplate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -185,37 +231,35 @@ func (m *MsgMintNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *MsgMintNFT) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMintNFT.Merge(m, src)
+func (m *MetadataTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetadataTemplate.Merge(m, src)
 }
-func (m *MsgMintNFT) XXX_Size() int {
+func (m *MetadataTemplate) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMintNFT) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMintNFT.DiscardUnknown(m)
+func (m *MetadataTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetadataTemplate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMintNFT proto.InternalMessageInfo
+var xxx_messageInfo_MetadataTemplate proto.InternalMessageInfo
 
-// MsgBurnNFT defines an SDK message for burning a NFT.
-type MsgBurnNFT struct {
-	Id     string                                        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Denom  string                                        `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	Sender github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
+type IDCollection struct {
+	Denom string   `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Ids   []string `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty"`
 }
 
-func (m *MsgBurnNFT) Reset()         { *m = MsgBurnNFT{} }
-func (m *MsgBurnNFT) String() string { return proto.CompactTextString(m) }
-func (*MsgBurnNFT) ProtoMessage()    {}
-func (*MsgBurnNFT) Descriptor() ([]byte, []int) {
+func (m *IDCollection) Reset()         { *m = IDCollection{} }
+func (m *IDCollection) String() string { return proto.CompactTextString(m) }
+func (*IDCollection) ProtoMessage()    {}
+func (*IDCollection) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d938547f84707355, []int{4}
 }
-func (m *MsgBurnNFT) XXX_Unmarshal(b []byte) error {
+func (m *IDCollection) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgBurnNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *IDCollection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgBurnNFT.Marshal(b, m, deterministic)
+		return xxx_messageInfo_IDCollection.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -225,39 +269,35 @@ func (m *MsgBurnNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *MsgBurnNFT) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBurnNFT.Merge(m, src)
+func (m *IDCollection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IDCollection.Merge(m, src)
 }
-func (m *MsgBurnNFT) XXX_Size() int {
+func (m *IDCollection) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgBurnNFT) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBurnNFT.DiscardUnknown(m)
+func (m *IDCollection) XXX_DiscardUnknown() {
+	xxx_messageInfo_IDCollection.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgBurnNFT proto.InternalMessageInfo
+var xxx_messageInfo_IDCollection proto.InternalMessageInfo
 
-// BaseNFT defines a non fungible token.
-type BaseNFT struct {
-	Id    string                                        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name  string                                        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	URI   string                                        `protobuf:"bytes,3,opt,name=uri,proto3" json:"uri,omitempty"`
-	Data  string                                        `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
-	Owner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,5,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+type Owner struct {
+	Address       github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
+	IDCollections []IDCollection                                `protobuf:"bytes,2,rep,name=id_collections,json=idCollections,proto3" json:"id_collections" yaml:"idcs"`
 }
 
-func (m *BaseNFT) Reset()         { *m = BaseNFT{} }
-func (m *BaseNFT) String() string { return proto.CompactTextString(m) }
-func (*BaseNFT) ProtoMessage()    {}
-func (*BaseNFT) Descriptor() ([]byte, []int) {
+func (m *Owner) Reset()         { *m = Owner{} }
+func (m *Owner) String() string { return proto.CompactTextString(m) }
+func (*Owner) ProtoMessage()    {}
+func (*Owner) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d938547f84707355, []int{5}
 }
-func (m *BaseNFT) XXX_Unmarshal(b []byte) error {
+func (m *Owner) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BaseNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Owner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BaseNFT.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Owner.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -267,38 +307,35 @@ func (m *BaseNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *BaseNFT) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BaseNFT.Merge(m, src)
+func (m *Owner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Owner.Merge(m, src)
 }
-func (m *BaseNFT) XXX_Size() int {
+func (m *Owner) XXX_Size() int {
 	return m.Size()
 }
-func (m *BaseNFT) XXX_DiscardUnknown() {
-	xxx_messageInfo_BaseNFT.DiscardUnknown(m)
+func (m *Owner) XXX_DiscardUnknown() {
+	xxx_messageInfo_Owner.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BaseNFT proto.InternalMessageInfo
+var xxx_messageInfo_Owner proto.InternalMessageInfo
 
-// Denom defines a type of NFT.
-type Denom struct {
-	Id      string                                        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name    string                                        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Schema  string                                        `protobuf:"bytes,3,opt,name=schema,proto3" json:"schema,omitempty"`
-	Creator github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=creator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"creator,omitempty"`
+type Collection struct {
+	Denom Denom     `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom"`
+	NFTs  []BaseNFT `protobuf:"bytes,2,rep,name=nfts,proto3" json:"nfts"`
 }
 
-func (m *Denom) Reset()         { *m = Denom{} }
-func (m *Denom) String() string { return proto.CompactTextString(m) }
-func (*Denom) ProtoMessage()    {}
-func (*Denom) Descriptor() ([]byte, []int) {
+func (m *Collection) Reset()         { *m = Collection{} }
+func (m *Collection) String() string { return proto.CompactTextString(m) }
+func (*Collection) ProtoMessage()    {}
+func (*Collection) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d938547f84707355, []int{6}
 }
-func (m *Denom) XXX_Unmarshal(b []byte) error {
+func (m *Collection) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Denom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Collection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Denom.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Collection.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -308,35 +345,37 @@ func (m *Denom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Denom) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Denom.Merge(m, src)
+func (m *Collection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Collection.Merge(m, src)
 }
-func (m *Denom) XXX_Size() int {
+func (m *Collection) XXX_Size() int {
 	return m.Size()
 }
-func (m *Denom) XXX_DiscardUnknown() {
-	xxx_messageInfo_Denom.DiscardUnknown(m)
+func (m *Collection) XXX_DiscardUnknown() {
+	xxx_messageInfo_Collection.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Denom proto.InternalMessageInfo
+var xxx_messageInfo_Collection proto.InternalMessageInfo
 
-type IDCollection struct {
-	Denom string   `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Ids   []string `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty"`
+// TraitCount defines the number of NFTs of a denom holding a trait.
+type TraitCount struct {
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Count uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (m *IDCollection) Reset()         { *m = IDCollection{} }
-func (m *IDCollection) String() string { return proto.CompactTextString(m) }
-func (*IDCollection) ProtoMessage()    {}
-func (*IDCollection) Descriptor() ([]byte, []int) {
+func (m *TraitCount) Reset()         { *m = TraitCount{} }
+func (m *TraitCount) String() string { return proto.CompactTextString(m) }
+func (*TraitCount) ProtoMessage()    {}
+func (*TraitCount) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d938547f84707355, []int{7}
 }
-func (m *IDCollection) XXX_Unmarshal(b []byte) error {
+func (m *TraitCount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *IDCollection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TraitCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_IDCollection.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TraitCount.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -346,35 +385,39 @@ func (m *IDCollection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *IDCollection) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IDCollection.Merge(m, src)
+func (m *TraitCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraitCount.Merge(m, src)
 }
-func (m *IDCollection) XXX_Size() int {
+func (m *TraitCount) XXX_Size() int {
 	return m.Size()
 }
-func (m *IDCollection) XXX_DiscardUnknown() {
-	xxx_messageInfo_IDCollection.DiscardUnknown(m)
+func (m *TraitCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraitCount.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_IDCollection proto.InternalMessageInfo
+var xxx_messageInfo_TraitCount proto.InternalMessageInfo
 
-type Owner struct {
-	Address       github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
-	IDCollections []IDCollection                                `protobuf:"bytes,2,rep,name=id_collections,json=idCollections,proto3" json:"id_collections" yaml:"idcs"`
+// HistoryEntry defines an ownership change of a NFT.
+type HistoryEntry struct {
+	Sequence uint64                                        `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Action   HistoryAction                                 `protobuf:"varint,2,opt,name=action,proto3,enum=irismod.nft.HistoryAction" json:"action,omitempty"`
+	From     github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=from,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"from,omitempty"`
+	To       github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=to,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"to,omitempty"`
+	Height   int64                                         `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *Owner) Reset()         { *m = Owner{} }
-func (m *Owner) String() string { return proto.CompactTextString(m) }
-func (*Owner) ProtoMessage()    {}
-func (*Owner) Descriptor() ([]byte, []int) {
+func (m *HistoryEntry) Reset()         { *m = HistoryEntry{} }
+func (m *HistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*HistoryEntry) ProtoMessage()    {}
+func (*HistoryEntry) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d938547f84707355, []int{8}
 }
-func (m *Owner) XXX_Unmarshal(b []byte) error {
+func (m *HistoryEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Owner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *HistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Owner.Marshal(b, m, deterministic)
+		return xxx_messageInfo_HistoryEntry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -384,35 +427,37 @@ func (m *Owner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Owner) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Owner.Merge(m, src)
+func (m *HistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryEntry.Merge(m, src)
 }
-func (m *Owner) XXX_Size() int {
+func (m *HistoryEntry) XXX_Size() int {
 	return m.Size()
 }
-func (m *Owner) XXX_DiscardUnknown() {
-	xxx_messageInfo_Owner.DiscardUnknown(m)
+func (m *HistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Owner proto.InternalMessageInfo
+var xxx_messageInfo_HistoryEntry proto.InternalMessageInfo
 
-type Collection struct {
-	Denom Denom     `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom"`
-	NFTs  []BaseNFT `protobuf:"bytes,2,rep,name=nfts,proto3" json:"nfts"`
+// TokenHistory defines the retained history of a NFT.
+type TokenHistory struct {
+	DenomId string         `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty"`
+	TokenId string         `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	Entries []HistoryEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries"`
 }
 
-func (m *Collection) Reset()         { *m = Collection{} }
-func (m *Collection) String() string { return proto.CompactTextString(m) }
-func (*Collection) ProtoMessage()    {}
-func (*Collection) Descriptor() ([]byte, []int) {
+func (m *TokenHistory) Reset()         { *m = TokenHistory{} }
+func (m *TokenHistory) String() string { return proto.CompactTextString(m) }
+func (*TokenHistory) ProtoMessage()    {}
+func (*TokenHistory) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d938547f84707355, []int{9}
 }
-func (m *Collection) XXX_Unmarshal(b []byte) error {
+func (m *TokenHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Collection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TokenHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Collection.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TokenHistory.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -422,158 +467,329 @@ func (m *Collection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Collection) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Collection.Merge(m, src)
+func (m *TokenHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenHistory.Merge(m, src)
 }
-func (m *Collection) XXX_Size() int {
+func (m *TokenHistory) XXX_Size() int {
 	return m.Size()
 }
-func (m *Collection) XXX_DiscardUnknown() {
-	xxx_messageInfo_Collection.DiscardUnknown(m)
+func (m *TokenHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenHistory.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Collection proto.InternalMessageInfo
+var xxx_messageInfo_TokenHistory proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgIssueDenom)(nil), "irismod.nft.MsgIssueDenom")
-	proto.RegisterType((*MsgTransferNFT)(nil), "irismod.nft.MsgTransferNFT")
-	proto.RegisterType((*MsgEditNFT)(nil), "irismod.nft.MsgEditNFT")
-	proto.RegisterType((*MsgMintNFT)(nil), "irismod.nft.MsgMintNFT")
-	proto.RegisterType((*MsgBurnNFT)(nil), "irismod.nft.MsgBurnNFT")
-	proto.RegisterType((*BaseNFT)(nil), "irismod.nft.BaseNFT")
-	proto.RegisterType((*Denom)(nil), "irismod.nft.Denom")
-	proto.RegisterType((*IDCollection)(nil), "irismod.nft.IDCollection")
-	proto.RegisterType((*Owner)(nil), "irismod.nft.Owner")
-	proto.RegisterType((*Collection)(nil), "irismod.nft.Collection")
+// Params defines the parameters of the nft module.
+type Params struct {
+	// deposit_per_byte is the deposit locked per byte of stored NFT
+	DepositPerByte types.Coin `protobuf:"bytes,1,opt,name=deposit_per_byte,json=depositPerByte,proto3" json:"deposit_per_byte" yaml:"deposit_per_byte"`
+	// paused halts the mints, edits, transfers and burns of every denom
+	Paused bool `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
 }
 
-func init() { proto.RegisterFile("types.proto", fileDescriptor_d938547f84707355) }
-
-var fileDescriptor_d938547f84707355 = []byte{
-	// 595 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x95, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x73, 0xb6, 0x93, 0x28, 0x2f, 0x6d, 0x85, 0xac, 0x0a, 0xb9, 0x08, 0xd9, 0x55, 0xc4,
-	0x90, 0xa5, 0x8e, 0x28, 0x12, 0x43, 0xc4, 0x52, 0x53, 0x8a, 0x22, 0x94, 0x56, 0xb2, 0xc2, 0xc2,
-	0x82, 0x5c, 0xdf, 0xc5, 0x3d, 0x11, 0xdf, 0x45, 0xbe, 0x8b, 0x50, 0x59, 0xf9, 0x02, 0x4c, 0x30,
-	0xc0, 0xc0, 0xce, 0xb7, 0x60, 0x40, 0x19, 0x3b, 0x32, 0x45, 0x90, 0x2c, 0xcc, 0x8c, 0x4c, 0xc8,
-	0xf6, 0xa5, 0x71, 0x44, 0x85, 0x50, 0xc2, 0x82, 0xc4, 0x94, 0xe7, 0x97, 0x77, 0xef, 0xfd, 0xde,
-	0xff, 0x6f, 0x9d, 0xa1, 0x2e, 0xcf, 0x87, 0x44, 0xb8, 0xc3, 0x84, 0x4b, 0x6e, 0xd6, 0x69, 0x42,
-	0x45, 0xcc, 0xb1, 0xcb, 0xfa, 0xf2, 0xc6, 0x76, 0xc4, 0x23, 0x9e, 0xe5, 0x5b, 0x69, 0x94, 0x97,
-	0x34, 0xde, 0x21, 0xd8, 0xec, 0x8a, 0xa8, 0x23, 0xc4, 0x88, 0x1c, 0x12, 0xc6, 0x63, 0x73, 0x0b,
-	0x34, 0x8a, 0x2d, 0xb4, 0x8b, 0x9a, 0x35, 0x5f, 0xa3, 0xd8, 0x34, 0xc1, 0x60, 0x41, 0x4c, 0x2c,
-	0x2d, 0xcb, 0x64, 0xb1, 0x79, 0x1d, 0x2a, 0x22, 0x3c, 0x23, 0x71, 0x60, 0xe9, 0x59, 0x56, 0x3d,
-	0x99, 0x1d, 0xa8, 0x08, 0xc2, 0x30, 0x49, 0x2c, 0x63, 0x17, 0x35, 0x37, 0xbc, 0xdb, 0x3f, 0x26,
-	0xce, 0x5e, 0x44, 0xe5, 0xd9, 0xe8, 0xd4, 0x0d, 0x79, 0xdc, 0x0a, 0xb9, 0x88, 0xb9, 0x50, 0x3f,
-	0x7b, 0x02, 0x3f, 0x6b, 0xe5, 0xb8, 0x07, 0x61, 0x78, 0x80, 0x71, 0x42, 0x84, 0xf0, 0x55, 0x83,
-	0xb6, 0xf1, 0xed, 0xbd, 0x83, 0x1a, 0x6f, 0x35, 0xd8, 0xea, 0x8a, 0xa8, 0x97, 0x04, 0x4c, 0xf4,
-	0x49, 0x72, 0x7c, 0xd4, 0xfb, 0x85, 0x6f, 0x1b, 0xca, 0x38, 0x05, 0x57, 0x80, 0xf9, 0xc3, 0x25,
-	0xb5, 0x5e, 0xa0, 0xde, 0x01, 0x7d, 0x94, 0xd0, 0x0c, 0xad, 0xe6, 0x55, 0xa7, 0x13, 0x47, 0x7f,
-	0xec, 0x77, 0xfc, 0x34, 0x97, 0x96, 0xe3, 0x40, 0x06, 0x56, 0x39, 0x2f, 0x4f, 0xe3, 0xc2, 0x32,
-	0x95, 0x35, 0x97, 0x31, 0x4f, 0xa0, 0x96, 0x90, 0x90, 0x0e, 0x29, 0x61, 0xd2, 0xaa, 0xae, 0xda,
-	0x6d, 0xd1, 0x43, 0xa9, 0xf3, 0x09, 0x01, 0x74, 0x45, 0xf4, 0x00, 0x53, 0xf9, 0x8f, 0x2a, 0xa3,
-	0x16, 0x79, 0xa3, 0x65, 0x8b, 0x74, 0x29, 0x93, 0xff, 0x2d, 0x5e, 0xb2, 0xf8, 0x65, 0x6e, 0xb1,
-	0x37, 0x4a, 0xd8, 0x9f, 0x2b, 0xb3, 0x58, 0x4b, 0xff, 0x3b, 0xfe, 0x7c, 0x40, 0x50, 0xf5, 0x02,
-	0x41, 0xae, 0x42, 0xb8, 0xea, 0x7e, 0x50, 0x36, 0xe8, 0xbf, 0xb1, 0xc1, 0x28, 0xd8, 0xf0, 0x10,
-	0xca, 0xfc, 0x39, 0x23, 0x49, 0xe6, 0xcd, 0x4a, 0xb8, 0xf9, 0x79, 0x45, 0xfb, 0x1a, 0x41, 0x79,
-	0xfd, 0xbb, 0xec, 0x11, 0x54, 0xc3, 0x84, 0x04, 0x92, 0xaf, 0x71, 0x99, 0xcd, 0x3b, 0x28, 0xb0,
-	0x7b, 0xb0, 0xd1, 0x39, 0xbc, 0xcf, 0x07, 0x03, 0x12, 0x4a, 0xca, 0xd9, 0xc2, 0x3d, 0x54, 0x74,
-	0xef, 0x1a, 0xe8, 0x14, 0x0b, 0x4b, 0xdb, 0xd5, 0x9b, 0x35, 0x3f, 0x0d, 0xd5, 0xe9, 0x8f, 0x08,
-	0xca, 0x27, 0xe9, 0x9a, 0x29, 0x5a, 0x90, 0x4f, 0xc8, 0x4e, 0xae, 0x86, 0xa6, 0x3a, 0x98, 0x7d,
-	0xd8, 0xa2, 0xf8, 0x69, 0x78, 0x49, 0x95, 0x4f, 0xae, 0xef, 0xef, 0xb8, 0x85, 0xaf, 0x87, 0x5b,
-	0xe4, 0xf6, 0x6e, 0x8d, 0x27, 0x4e, 0x69, 0x3a, 0x71, 0x36, 0x8b, 0x59, 0xf1, 0x7d, 0xe2, 0xd4,
-	0xcf, 0x83, 0x78, 0xd0, 0x6e, 0x50, 0x1c, 0x8a, 0x86, 0xbf, 0x49, 0x71, 0xe1, 0x5f, 0xb5, 0xc4,
-	0x0b, 0x80, 0x82, 0x00, 0x6e, 0x51, 0x80, 0xfa, 0xbe, 0xb9, 0x34, 0x32, 0xb3, 0xd0, 0x33, 0xd2,
-	0x59, 0x73, 0x69, 0xee, 0x82, 0xc1, 0xfa, 0x72, 0x4e, 0xb8, 0xbd, 0x54, 0xae, 0xde, 0x4f, 0x6f,
-	0x43, 0xc1, 0x19, 0xc7, 0x47, 0x3d, 0xe1, 0x67, 0xf5, 0xf9, 0x6c, 0xaf, 0x3d, 0xfe, 0x6a, 0x97,
-	0xc6, 0x53, 0x1b, 0x5d, 0x4c, 0x6d, 0xf4, 0x65, 0x6a, 0xa3, 0x57, 0x33, 0xbb, 0x74, 0x31, 0xb3,
-	0x4b, 0x9f, 0x67, 0x76, 0xe9, 0xc9, 0xcd, 0x82, 0x7e, 0xaa, 0x6f, 0x8b, 0xf5, 0x65, 0xae, 0xdc,
-	0x69, 0x25, 0xfb, 0x5c, 0xde, 0xf9, 0x19, 0x00, 0x00, 0xff, 0xff, 0xec, 0x00, 0xb0, 0x1a, 0x60,
-	0x07, 0x00, 0x00,
-}
-
-func (this *MsgIssueDenom) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*MsgIssueDenom)
-	if !ok {
-		that2, ok := that.(MsgIssueDenom)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{10}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Id != that1.Id {
-		return false
-	}
-	if this.Name != that1.Name {
-		return false
-	}
-	if this.Schema != that1.Schema {
-		return false
-	}
-	if !bytes.Equal(this.Sender, that1.Sender) {
-		return false
-	}
-	return true
 }
-func (this *MsgTransferNFT) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*MsgTransferNFT)
-	if !ok {
-		that2, ok := that.(MsgTransferNFT)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+// TokenDeposit defines the storage deposit locked for a NFT.
+type TokenDeposit struct {
+	DenomId string     `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty"`
+	TokenId string     `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	Amount  types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *TokenDeposit) Reset()         { *m = TokenDeposit{} }
+func (m *TokenDeposit) String() string { return proto.CompactTextString(m) }
+func (*TokenDeposit) ProtoMessage()    {}
+func (*TokenDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{11}
+}
+func (m *TokenDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Id != that1.Id {
-		return false
-	}
-	if this.Denom != that1.Denom {
-		return false
-	}
-	if this.Name != that1.Name {
-		return false
-	}
-	if this.URI != that1.URI {
-		return false
-	}
-	if this.Data != that1.Data {
-		return false
+}
+func (m *TokenDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenDeposit.Merge(m, src)
+}
+func (m *TokenDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenDeposit proto.InternalMessageInfo
+
+// Hidden defines a NFT, or a whole denom when token_id is empty, hidden by governance.
+type Hidden struct {
+	DenomId string `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty"`
+	TokenId string `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+}
+
+func (m *Hidden) Reset()         { *m = Hidden{} }
+func (m *Hidden) String() string { return proto.CompactTextString(m) }
+func (*Hidden) ProtoMessage()    {}
+func (*Hidden) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{12}
+}
+func (m *Hidden) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Hidden) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Hidden.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if !bytes.Equal(this.Sender, that1.Sender) {
-		return false
+}
+func (m *Hidden) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Hidden.Merge(m, src)
+}
+func (m *Hidden) XXX_Size() int {
+	return m.Size()
+}
+func (m *Hidden) XXX_DiscardUnknown() {
+	xxx_messageInfo_Hidden.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Hidden proto.InternalMessageInfo
+
+// DenomTransferPolicy defines the transfer policy evaluated for the NFTs of a denom.
+type DenomTransferPolicy struct {
+	DenomId string `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty"`
+	Policy  string `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (m *DenomTransferPolicy) Reset()         { *m = DenomTransferPolicy{} }
+func (m *DenomTransferPolicy) String() string { return proto.CompactTextString(m) }
+func (*DenomTransferPolicy) ProtoMessage()    {}
+func (*DenomTransferPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{13}
+}
+func (m *DenomTransferPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomTransferPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomTransferPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if !bytes.Equal(this.Recipient, that1.Recipient) {
-		return false
+}
+func (m *DenomTransferPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomTransferPolicy.Merge(m, src)
+}
+func (m *DenomTransferPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomTransferPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomTransferPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomTransferPolicy proto.InternalMessageInfo
+
+// PolicyAddress defines an address listed in the allow or deny list of a denom.
+type PolicyAddress struct {
+	DenomId string `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty"`
+	List    string `protobuf:"bytes,2,opt,name=list,proto3" json:"list,omitempty"`
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *PolicyAddress) Reset()         { *m = PolicyAddress{} }
+func (m *PolicyAddress) String() string { return proto.CompactTextString(m) }
+func (*PolicyAddress) ProtoMessage()    {}
+func (*PolicyAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{14}
+}
+func (m *PolicyAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PolicyAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PolicyAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *MsgEditNFT) Equal(that interface{}) bool {
+func (m *PolicyAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicyAddress.Merge(m, src)
+}
+func (m *PolicyAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *PolicyAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicyAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolicyAddress proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("irismod.nft.HistoryAction", HistoryAction_name, HistoryAction_value)
+	proto.RegisterType((*BaseNFT)(nil), "irismod.nft.BaseNFT")
+	proto.RegisterType((*Attribute)(nil), "irismod.nft.Attribute")
+	proto.RegisterType((*Denom)(nil), "irismod.nft.Denom")
+	proto.RegisterType((*MetadataTemplate)(nil), "irismod.nft.MetadataTemplate")
+	proto.RegisterType((*IDCollection)(nil), "irismod.nft.IDCollection")
+	proto.RegisterType((*Owner)(nil), "irismod.nft.Owner")
+	proto.RegisterType((*Collection)(nil), "irismod.nft.Collection")
+	proto.RegisterType((*TraitCount)(nil), "irismod.nft.TraitCount")
+	proto.RegisterType((*HistoryEntry)(nil), "irismod.nft.HistoryEntry")
+	proto.RegisterType((*TokenHistory)(nil), "irismod.nft.TokenHistory")
+	proto.RegisterType((*Params)(nil), "irismod.nft.Params")
+	proto.RegisterType((*TokenDeposit)(nil), "irismod.nft.TokenDeposit")
+	proto.RegisterType((*Hidden)(nil), "irismod.nft.Hidden")
+	proto.RegisterType((*DenomTransferPolicy)(nil), "irismod.nft.DenomTransferPolicy")
+	proto.RegisterType((*PolicyAddress)(nil), "irismod.nft.PolicyAddress")
+}
+
+func init() { proto.RegisterFile("types.proto", fileDescriptor_d938547f84707355) }
+
+var fileDescriptor_d938547f84707355 = []byte{
+	// 1279 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6f, 0x5a, 0xc7,
+	0x16, 0xe6, 0xc2, 0xc5, 0xd8, 0x07, 0xec, 0x47, 0x26, 0x4e, 0x72, 0x8d, 0xf2, 0x00, 0x59, 0x4f,
+	0x4f, 0xd6, 0x7b, 0x0a, 0x28, 0x54, 0x4d, 0x15, 0x2b, 0x1b, 0xb0, 0x71, 0x4d, 0x53, 0x63, 0x6b,
+	0x02, 0x55, 0xdb, 0x0d, 0x1a, 0xee, 0x1d, 0xcc, 0xc8, 0xdc, 0x7b, 0xe9, 0xdc, 0xc1, 0x2d, 0xdd,
+	0x56, 0xad, 0x2a, 0xaf, 0xd2, 0x5d, 0x36, 0x96, 0x22, 0xf5, 0x3f, 0xe9, 0x2a, 0xcb, 0x2c, 0xab,
+	0x56, 0x42, 0x2d, 0xd9, 0x74, 0x9d, 0x65, 0x57, 0xd5, 0xcc, 0x9d, 0x8b, 0x01, 0x59, 0x51, 0xe4,
+	0x15, 0x73, 0xce, 0x7c, 0xe7, 0xcc, 0xf9, 0xf1, 0x9d, 0x73, 0x81, 0xb4, 0x18, 0x0f, 0x69, 0x50,
+	0x1a, 0x72, 0x5f, 0xf8, 0x28, 0xcd, 0x38, 0x0b, 0x5c, 0xdf, 0x29, 0x79, 0x3d, 0x91, 0xdb, 0x3c,
+	0xf5, 0x4f, 0x7d, 0xa5, 0x2f, 0xcb, 0x53, 0x08, 0xc9, 0xe5, 0x6d, 0x3f, 0x70, 0xfd, 0xa0, 0xdc,
+	0x25, 0x01, 0x2d, 0x9f, 0x3f, 0xec, 0x52, 0x41, 0x1e, 0x96, 0x6d, 0x9f, 0x79, 0xe1, 0xfd, 0xf6,
+	0x4f, 0x71, 0x48, 0xd5, 0x48, 0x40, 0x9b, 0x07, 0x2d, 0xb4, 0x01, 0x71, 0xe6, 0x58, 0x46, 0xd1,
+	0xd8, 0x59, 0xc3, 0x71, 0xe6, 0x20, 0x04, 0xa6, 0x47, 0x5c, 0x6a, 0xc5, 0x95, 0x46, 0x9d, 0xd1,
+	0x16, 0x24, 0x46, 0x9c, 0x59, 0x09, 0xa9, 0xaa, 0xa5, 0xa6, 0x93, 0x42, 0xa2, 0x8d, 0x1b, 0x58,
+	0xea, 0x24, 0xdc, 0x21, 0x82, 0x58, 0x66, 0x08, 0x97, 0x67, 0xf4, 0x31, 0x24, 0xfd, 0xaf, 0x3d,
+	0xca, 0xad, 0x64, 0xd1, 0xd8, 0xc9, 0xd4, 0x1e, 0xfe, 0x3d, 0x29, 0x3c, 0x38, 0x65, 0xa2, 0x3f,
+	0xea, 0x96, 0x6c, 0xdf, 0x2d, 0xeb, 0xe0, 0xc2, 0x9f, 0x07, 0x81, 0x73, 0x56, 0x0e, 0xd3, 0xab,
+	0xda, 0x76, 0xd5, 0x71, 0x38, 0x0d, 0x02, 0x1c, 0xda, 0xa3, 0xff, 0xc2, 0xea, 0x88, 0xb3, 0x4e,
+	0x9f, 0x04, 0x7d, 0x6b, 0x45, 0x3d, 0x9e, 0x9e, 0x4e, 0x0a, 0xa9, 0x36, 0x6e, 0x1c, 0x92, 0xa0,
+	0x8f, 0x53, 0x23, 0xce, 0xe4, 0x01, 0x3d, 0x01, 0x20, 0x42, 0x70, 0xd6, 0x1d, 0x09, 0x1a, 0x58,
+	0xa9, 0x62, 0x62, 0x27, 0x5d, 0xb9, 0x5b, 0x9a, 0xab, 0x53, 0xa9, 0x1a, 0x5d, 0xd7, 0xcc, 0x57,
+	0x93, 0x42, 0x0c, 0xcf, 0xe1, 0x77, 0xcd, 0xbf, 0x5e, 0x16, 0x8c, 0xed, 0x23, 0x58, 0x9b, 0x81,
+	0x50, 0x16, 0x12, 0x67, 0x74, 0xac, 0xab, 0x22, 0x8f, 0x68, 0x13, 0x92, 0xe7, 0x64, 0x30, 0x8a,
+	0xea, 0x12, 0x0a, 0x32, 0x7b, 0x19, 0x7b, 0x58, 0x19, 0xac, 0xce, 0xda, 0xdd, 0x8b, 0x04, 0x24,
+	0xf7, 0xa9, 0xe7, 0xbb, 0xef, 0x55, 0xe0, 0xbb, 0xb0, 0x12, 0xd8, 0x7d, 0xea, 0x12, 0xed, 0x49,
+	0x4b, 0xe8, 0x29, 0xa4, 0x6c, 0x4e, 0x89, 0xf0, 0xb9, 0x65, 0xde, 0xb4, 0x96, 0x91, 0x87, 0xa8,
+	0x8b, 0xc9, 0x6b, 0xba, 0xf8, 0xbe, 0x85, 0xfe, 0x3f, 0xdc, 0xea, 0xb3, 0x40, 0xf8, 0x7c, 0xdc,
+	0xe1, 0x54, 0x50, 0x4f, 0x30, 0xdf, 0xb3, 0x52, 0x45, 0x63, 0xc7, 0xc4, 0x59, 0x7d, 0x81, 0x23,
+	0x3d, 0xba, 0x0f, 0x6b, 0x9c, 0x9e, 0xfb, 0x36, 0xe9, 0x0e, 0xa8, 0xb5, 0x5a, 0x34, 0x76, 0x56,
+	0xf1, 0x95, 0x02, 0x7d, 0x02, 0xb7, 0x5c, 0x2a, 0x88, 0x24, 0x4c, 0x47, 0x50, 0x77, 0x38, 0x20,
+	0x82, 0x5a, 0x6b, 0x45, 0x63, 0x27, 0x5d, 0xf9, 0xf7, 0x42, 0xeb, 0x8e, 0x34, 0xaa, 0xa5, 0x41,
+	0x38, 0xeb, 0x2e, 0x69, 0x64, 0xf8, 0x92, 0xea, 0x1d, 0x99, 0x1e, 0x5c, 0x85, 0x2f, 0x29, 0x2e,
+	0x53, 0x4c, 0xc9, 0xcb, 0x36, 0x67, 0xba, 0x35, 0xbf, 0x1b, 0x90, 0x5d, 0x76, 0x8a, 0x8a, 0x90,
+	0x76, 0x68, 0x60, 0x73, 0x36, 0x54, 0x39, 0x85, 0xed, 0x9a, 0x57, 0x49, 0x06, 0x30, 0x97, 0x9c,
+	0xce, 0x18, 0xa0, 0x04, 0x54, 0x81, 0x0c, 0xfd, 0x46, 0x50, 0xee, 0x91, 0x41, 0x67, 0xc4, 0x07,
+	0x7a, 0x46, 0xfe, 0x35, 0x9d, 0x14, 0xd2, 0x75, 0xad, 0x6f, 0xe3, 0x4f, 0x71, 0x3a, 0x02, 0xb5,
+	0xf9, 0x00, 0x7d, 0x08, 0xeb, 0xc4, 0x63, 0x2e, 0x91, 0x6e, 0x95, 0x91, 0x1a, 0x9e, 0x5a, 0x76,
+	0x3a, 0x29, 0x64, 0xaa, 0xd1, 0x85, 0xb4, 0xca, 0xcc, 0x60, 0xd2, 0x2c, 0xbf, 0xc0, 0x72, 0xd5,
+	0xc6, 0x6b, 0x78, 0xfc, 0x04, 0x32, 0x8d, 0xfd, 0x3d, 0x7f, 0x30, 0xa0, 0x76, 0x14, 0xb6, 0x23,
+	0x79, 0xa8, 0x53, 0x0a, 0x05, 0x49, 0x70, 0xe6, 0x04, 0x56, 0xbc, 0x98, 0x90, 0x04, 0x67, 0x4e,
+	0x64, 0xfd, 0x8b, 0x01, 0xc9, 0x63, 0x35, 0x7b, 0x4f, 0x21, 0x45, 0x42, 0x06, 0x59, 0xc6, 0x8d,
+	0xa9, 0xa7, 0x3d, 0xa0, 0x1e, 0x6c, 0x30, 0xa7, 0x63, 0xcf, 0xa2, 0x0a, 0x5f, 0x4e, 0x57, 0xb6,
+	0x16, 0x3a, 0x3d, 0x1f, 0x77, 0xed, 0x3f, 0x72, 0x4e, 0xa7, 0x93, 0xc2, 0xfa, 0xbc, 0x36, 0x78,
+	0x3b, 0x29, 0xa4, 0xc7, 0xc4, 0x1d, 0xec, 0x6e, 0x33, 0xc7, 0x0e, 0xb6, 0xf1, 0x3a, 0x73, 0xe6,
+	0x6e, 0x75, 0x12, 0xdf, 0x02, 0x5c, 0x29, 0x51, 0x69, 0xbe, 0x00, 0xe9, 0x0a, 0x5a, 0x78, 0x52,
+	0x8d, 0xa8, 0xde, 0x09, 0xba, 0x34, 0x8f, 0xc0, 0xf4, 0x7a, 0x22, 0x8a, 0x70, 0x73, 0x01, 0xae,
+	0x97, 0x66, 0x2d, 0xa3, 0x83, 0x33, 0x9b, 0x07, 0xad, 0x00, 0x2b, 0xbc, 0x7e, 0xfb, 0x04, 0xa0,
+	0xc5, 0x09, 0x13, 0x7b, 0xfe, 0xc8, 0x13, 0xef, 0xbd, 0x47, 0x36, 0x21, 0x69, 0x4b, 0x03, 0x45,
+	0x1f, 0x13, 0x87, 0x82, 0xf6, 0xf8, 0x43, 0x1c, 0x32, 0x87, 0xe1, 0x6c, 0xd5, 0x3d, 0xc1, 0xc7,
+	0x28, 0x07, 0xab, 0x01, 0xfd, 0x6a, 0x44, 0x3d, 0x9b, 0x2a, 0xcf, 0x26, 0x9e, 0xc9, 0xa8, 0x02,
+	0x2b, 0x44, 0xa5, 0xad, 0xfc, 0x6f, 0x54, 0x72, 0x0b, 0xe1, 0x6b, 0x37, 0x55, 0x85, 0xc0, 0x1a,
+	0x89, 0xea, 0x60, 0xf6, 0xb8, 0xef, 0x5a, 0x89, 0x9b, 0xb6, 0x59, 0x99, 0xa3, 0x2a, 0xc4, 0x85,
+	0x7f, 0xf3, 0x35, 0x15, 0x17, 0xbe, 0x5c, 0x83, 0x7d, 0xca, 0x4e, 0xfb, 0x42, 0xb1, 0x3b, 0x81,
+	0xb5, 0xa4, 0x0b, 0xf1, 0xbd, 0x01, 0x99, 0x96, 0x7f, 0x46, 0x3d, 0x9d, 0x06, 0xda, 0x82, 0x55,
+	0xd5, 0xb2, 0xce, 0x6c, 0xbf, 0xa6, 0x94, 0xdc, 0x70, 0xe4, 0x95, 0x90, 0x50, 0x79, 0x15, 0x56,
+	0x3a, 0xa5, 0xe4, 0x86, 0x83, 0x1e, 0x43, 0x8a, 0x7a, 0x82, 0x33, 0x1a, 0x58, 0x89, 0x6b, 0x48,
+	0x38, 0x5f, 0x6a, 0x4d, 0x8c, 0x08, 0xaf, 0xe3, 0x78, 0x6e, 0xc0, 0xca, 0x09, 0xe1, 0xc4, 0x0d,
+	0x90, 0x03, 0x59, 0x87, 0x0e, 0xfd, 0x80, 0x89, 0xce, 0x90, 0xf2, 0x4e, 0x77, 0x2c, 0xa8, 0xa6,
+	0xd9, 0x56, 0x29, 0x4c, 0xb6, 0x24, 0x77, 0x4f, 0x49, 0x7f, 0x83, 0x4b, 0x7b, 0x3e, 0xf3, 0x6a,
+	0x05, 0xe9, 0xf4, 0xed, 0xa4, 0x70, 0x2f, 0x24, 0xf2, 0xb2, 0x83, 0x6d, 0xbc, 0xa1, 0x55, 0x27,
+	0x94, 0xd7, 0xc6, 0x42, 0x7d, 0x1d, 0x86, 0x64, 0x14, 0xd0, 0x30, 0x95, 0x55, 0xac, 0xa5, 0xdd,
+	0xd5, 0x17, 0x2f, 0x0b, 0x31, 0x15, 0xd2, 0x77, 0x51, 0x69, 0xf6, 0x43, 0xcb, 0x1b, 0x96, 0xe6,
+	0x23, 0x58, 0x21, 0xee, 0x8c, 0x87, 0xef, 0x4c, 0x22, 0xac, 0x8c, 0x86, 0xeb, 0xc2, 0xec, 0xc3,
+	0xca, 0x21, 0x73, 0x1c, 0xea, 0xdd, 0xec, 0x79, 0xed, 0xe5, 0x10, 0x6e, 0xab, 0xa9, 0x6c, 0x71,
+	0xe2, 0x05, 0x3d, 0xca, 0x4f, 0xfc, 0x01, 0xb3, 0xdf, 0xd9, 0x6c, 0x59, 0x1f, 0x05, 0xd2, 0x0e,
+	0xb5, 0xb4, 0xfd, 0x39, 0xac, 0x87, 0xc6, 0x9a, 0x63, 0xef, 0xf2, 0x81, 0xc0, 0x1c, 0xb0, 0x40,
+	0x44, 0x5f, 0x65, 0x79, 0x46, 0xd6, 0xd5, 0x0a, 0x0c, 0x3f, 0xcb, 0x91, 0xf8, 0xbf, 0xdf, 0xe2,
+	0xb0, 0xbe, 0x30, 0x4c, 0xe8, 0x09, 0xe4, 0x0e, 0x1b, 0xcf, 0x5a, 0xc7, 0xf8, 0x8b, 0x4e, 0x75,
+	0xaf, 0xd5, 0x38, 0x6e, 0x76, 0xda, 0xcd, 0x67, 0x27, 0xf5, 0xbd, 0xc6, 0x41, 0xa3, 0xbe, 0x9f,
+	0x8d, 0xe5, 0xee, 0x5f, 0x5c, 0x16, 0xad, 0x05, 0x93, 0xb6, 0x17, 0x0c, 0xa9, 0xcd, 0x7a, 0x8c,
+	0x3a, 0xa8, 0x04, 0xb7, 0x97, 0xac, 0x8f, 0x1a, 0xcd, 0x56, 0xd6, 0xc8, 0xdd, 0xb9, 0xb8, 0x2c,
+	0xde, 0x5a, 0x30, 0x3b, 0x62, 0x9e, 0x40, 0x8f, 0xe0, 0xde, 0x12, 0xbe, 0x85, 0xab, 0xcd, 0x67,
+	0x07, 0x75, 0x9c, 0x8d, 0xe7, 0xb6, 0x2e, 0x2e, 0x8b, 0x77, 0x16, 0x6c, 0xa2, 0x52, 0x5e, 0xf3,
+	0x4e, 0xad, 0x8d, 0x9b, 0xd9, 0xc4, 0x35, 0xef, 0xd4, 0x46, 0xdc, 0x43, 0x15, 0xb8, 0xb3, 0x84,
+	0xc7, 0xf5, 0xcf, 0x8e, 0x9f, 0xd6, 0xb3, 0x66, 0xee, 0xde, 0xc5, 0x65, 0xf1, 0xf6, 0x82, 0x05,
+	0xa6, 0xe7, 0xfe, 0x19, 0x45, 0x8f, 0x61, 0x6b, 0xc9, 0xe6, 0xe0, 0x18, 0xef, 0xd5, 0xc3, 0x97,
+	0x92, 0xb9, 0xdc, 0xc5, 0x65, 0xf1, 0xee, 0x82, 0xdd, 0x81, 0xcf, 0x6d, 0x2a, 0x9f, 0xcb, 0x99,
+	0x3f, 0xfe, 0x9c, 0x8f, 0xd5, 0x76, 0x5f, 0xfd, 0x99, 0x8f, 0xbd, 0x9a, 0xe6, 0x8d, 0xd7, 0xd3,
+	0xbc, 0xf1, 0xc7, 0x34, 0x6f, 0x3c, 0x7f, 0x93, 0x8f, 0xbd, 0x7e, 0x93, 0x8f, 0xfd, 0xfa, 0x26,
+	0x1f, 0xfb, 0xf2, 0xfe, 0xdc, 0x5a, 0xd1, 0x73, 0x5b, 0xf6, 0x7a, 0x22, 0x5c, 0x28, 0xdd, 0x15,
+	0xf5, 0x07, 0xf7, 0x83, 0x7f, 0x06, 0x00, 0x58, 0x21, 0xfc, 0x41, 0x32, 0x0b, 0x00, 0x00,
+}
+
+func (this *BaseNFT) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgEditNFT)
+	that1, ok := that.(*BaseNFT)
 	if !ok {
-		that2, ok := that.(MsgEditNFT)
+		that2, ok := that.(BaseNFT)
 		if ok {
 			that1 = &that2
 		} else {
@@ -588,9 +804,6 @@ func (this *MsgEditNFT) Equal(that interface{}) bool {
 	if this.Id != that1.Id {
 		return false
 	}
-	if this.Denom != that1.Denom {
-		return false
-	}
 	if this.Name != that1.Name {
 		return false
 	}
@@ -600,19 +813,30 @@ func (this *MsgEditNFT) Equal(that interface{}) bool {
 	if this.Data != that1.Data {
 		return false
 	}
-	if !bytes.Equal(this.Sender, that1.Sender) {
+	if !bytes.Equal(this.Owner, that1.Owner) {
+		return false
+	}
+	if this.URIHash != that1.URIHash {
 		return false
 	}
+	if len(this.Attributes) != len(that1.Attributes) {
+		return false
+	}
+	for i := range this.Attributes {
+		if !this.Attributes[i].Equal(&that1.Attributes[i]) {
+			return false
+		}
+	}
 	return true
 }
-func (this *MsgMintNFT) Equal(that interface{}) bool {
+func (this *Attribute) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgMintNFT)
+	that1, ok := that.(*Attribute)
 	if !ok {
-		that2, ok := that.(MsgMintNFT)
+		that2, ok := that.(Attribute)
 		if ok {
 			that1 = &that2
 		} else {
@@ -624,37 +848,25 @@ func (this *MsgMintNFT) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Id != that1.Id {
-		return false
-	}
-	if this.Denom != that1.Denom {
-		return false
-	}
-	if this.Name != that1.Name {
-		return false
-	}
-	if this.URI != that1.URI {
-		return false
-	}
-	if this.Data != that1.Data {
+	if this.Key != that1.Key {
 		return false
 	}
-	if !bytes.Equal(this.Sender, that1.Sender) {
+	if this.Value != that1.Value {
 		return false
 	}
-	if !bytes.Equal(this.Recipient, that1.Recipient) {
+	if this.Type != that1.Type {
 		return false
 	}
 	return true
 }
-func (this *MsgBurnNFT) Equal(that interface{}) bool {
+func (this *Denom) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgBurnNFT)
+	that1, ok := that.(*Denom)
 	if !ok {
-		that2, ok := that.(MsgBurnNFT)
+		that2, ok := that.(Denom)
 		if ok {
 			that1 = &that2
 		} else {
@@ -669,58 +881,43 @@ func (this *MsgBurnNFT) Equal(that interface{}) bool {
 	if this.Id != that1.Id {
 		return false
 	}
-	if this.Denom != that1.Denom {
+	if this.Name != that1.Name {
 		return false
 	}
-	if !bytes.Equal(this.Sender, that1.Sender) {
+	if this.Schema != that1.Schema {
 		return false
 	}
-	return true
-}
-func (this *BaseNFT) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*BaseNFT)
-	if !ok {
-		that2, ok := that.(BaseNFT)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+	if !bytes.Equal(this.Creator, that1.Creator) {
+		return false
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
+	if this.URI != that1.URI {
 		return false
 	}
-	if this.Id != that1.Id {
+	if this.URIHash != that1.URIHash {
 		return false
 	}
-	if this.Name != that1.Name {
+	if this.HistoryRetention != that1.HistoryRetention {
 		return false
 	}
-	if this.URI != that1.URI {
+	if this.Revocable != that1.Revocable {
 		return false
 	}
-	if this.Data != that1.Data {
+	if !this.MetadataTemplate.Equal(that1.MetadataTemplate) {
 		return false
 	}
-	if !bytes.Equal(this.Owner, that1.Owner) {
+	if this.BaseURI != that1.BaseURI {
 		return false
 	}
 	return true
 }
-func (this *Denom) Equal(that interface{}) bool {
+func (this *MetadataTemplate) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Denom)
+	that1, ok := that.(*MetadataTemplate)
 	if !ok {
-		that2, ok := that.(Denom)
+		that2, ok := that.(MetadataTemplate)
 		if ok {
 			that1 = &that2
 		} else {
@@ -732,16 +929,19 @@ func (this *Denom) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Id != that1.Id {
+	if this.Description != that1.Description {
 		return false
 	}
-	if this.Name != that1.Name {
+	if this.Image != that1.Image {
 		return false
 	}
-	if this.Schema != that1.Schema {
+	if this.ExternalURL != that1.ExternalURL {
 		return false
 	}
-	if !bytes.Equal(this.Creator, that1.Creator) {
+	if this.AnimationURL != that1.AnimationURL {
+		return false
+	}
+	if this.Attributes != that1.Attributes {
 		return false
 	}
 	return true
@@ -842,195 +1042,192 @@ func (this *Collection) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (m *MsgIssueDenom) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *TraitCount) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *MsgIssueDenom) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgIssueDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x22
+	that1, ok := that.(*TraitCount)
+	if !ok {
+		that2, ok := that.(TraitCount)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Schema) > 0 {
-		i -= len(m.Schema)
-		copy(dAtA[i:], m.Schema)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Schema)))
-		i--
-		dAtA[i] = 0x1a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+	if this.Key != that1.Key {
+		return false
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	if this.Value != that1.Value {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgTransferNFT) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Count != that1.Count {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgTransferNFT) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *HistoryEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgTransferNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x3a
+	that1, ok := that.(*HistoryEntry)
+	if !ok {
+		that2, ok := that.(HistoryEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x32
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x2a
+	if this.Sequence != that1.Sequence {
+		return false
 	}
-	if len(m.URI) > 0 {
-		i -= len(m.URI)
-		copy(dAtA[i:], m.URI)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.URI)))
-		i--
-		dAtA[i] = 0x22
+	if this.Action != that1.Action {
+		return false
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
+	if !bytes.Equal(this.From, that1.From) {
+		return false
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+	if !bytes.Equal(this.To, that1.To) {
+		return false
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	if this.Height != that1.Height {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
+func (this *TokenHistory) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgEditNFT) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*TokenHistory)
+	if !ok {
+		that2, ok := that.(TokenHistory)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DenomId != that1.DenomId {
+		return false
+	}
+	if this.TokenId != that1.TokenId {
+		return false
+	}
+	if len(this.Entries) != len(that1.Entries) {
+		return false
+	}
+	for i := range this.Entries {
+		if !this.Entries[i].Equal(&that1.Entries[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgEditNFT) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.DepositPerByte.Equal(&that1.DepositPerByte) {
+		return false
+	}
+	if this.Paused != that1.Paused {
+		return false
+	}
+	return true
 }
-
-func (m *MsgEditNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x32
+func (this *TokenDeposit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x2a
+
+	that1, ok := that.(*TokenDeposit)
+	if !ok {
+		that2, ok := that.(TokenDeposit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.URI) > 0 {
-		i -= len(m.URI)
-		copy(dAtA[i:], m.URI)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.URI)))
-		i--
-		dAtA[i] = 0x22
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
+	if this.DenomId != that1.DenomId {
+		return false
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+	if this.TokenId != that1.TokenId {
+		return false
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	if !this.Amount.Equal(&that1.Amount) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
+func (this *Hidden) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgMintNFT) Marshal() (dAtA []byte, err error) {
+	that1, ok := that.(*Hidden)
+	if !ok {
+		that2, ok := that.(Hidden)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DenomId != that1.DenomId {
+		return false
+	}
+	if this.TokenId != that1.TokenId {
+		return false
+	}
+	return true
+}
+func (m *BaseNFT) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1040,56 +1237,63 @@ func (m *MsgMintNFT) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgMintNFT) MarshalTo(dAtA []byte) (int, error) {
+func (m *BaseNFT) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMintNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BaseNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x3a
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
+	if len(m.URIHash) > 0 {
+		i -= len(m.URIHash)
+		copy(dAtA[i:], m.URIHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.URIHash)))
 		i--
 		dAtA[i] = 0x32
 	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Data) > 0 {
 		i -= len(m.Data)
 		copy(dAtA[i:], m.Data)
 		i = encodeVarintTypes(dAtA, i, uint64(len(m.Data)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x22
 	}
 	if len(m.URI) > 0 {
 		i -= len(m.URI)
 		copy(dAtA[i:], m.URI)
 		i = encodeVarintTypes(dAtA, i, uint64(len(m.URI)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x1a
 	}
 	if len(m.Name) > 0 {
 		i -= len(m.Name)
 		copy(dAtA[i:], m.Name)
 		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
-		i--
 		dAtA[i] = 0x12
 	}
 	if len(m.Id) > 0 {
@@ -1102,7 +1306,7 @@ func (m *MsgMintNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBurnNFT) Marshal() (dAtA []byte, err error) {
+func (m *Attribute) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1112,41 +1316,41 @@ func (m *MsgBurnNFT) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgBurnNFT) MarshalTo(dAtA []byte) (int, error) {
+func (m *Attribute) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBurnNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Attribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Type)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Value)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Id)))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Key)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BaseNFT) Marshal() (dAtA []byte, err error) {
+func (m *Denom) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1156,35 +1360,76 @@ func (m *BaseNFT) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BaseNFT) MarshalTo(dAtA []byte) (int, error) {
+func (m *Denom) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BaseNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
+	if len(m.BaseURI) > 0 {
+		i -= len(m.BaseURI)
+		copy(dAtA[i:], m.BaseURI)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BaseURI)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x52
 	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Data)))
+	if m.MetadataTemplate != nil {
+		{
+			size, err := m.MetadataTemplate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x4a
+	}
+	if m.Revocable {
+		i--
+		if m.Revocable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.HistoryRetention != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.HistoryRetention))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.URIHash) > 0 {
+		i -= len(m.URIHash)
+		copy(dAtA[i:], m.URIHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.URIHash)))
+		i--
+		dAtA[i] = 0x32
 	}
 	if len(m.URI) > 0 {
 		i -= len(m.URI)
 		copy(dAtA[i:], m.URI)
 		i = encodeVarintTypes(dAtA, i, uint64(len(m.URI)))
 		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Schema)))
+		i--
 		dAtA[i] = 0x1a
 	}
 	if len(m.Name) > 0 {
@@ -1204,7 +1449,7 @@ func (m *BaseNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Denom) Marshal() (dAtA []byte, err error) {
+func (m *MetadataTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1214,41 +1459,48 @@ func (m *Denom) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Denom) MarshalTo(dAtA []byte) (int, error) {
+func (m *MetadataTemplate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MetadataTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
+	if len(m.Attributes) > 0 {
+		i -= len(m.Attributes)
+		copy(dAtA[i:], m.Attributes)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Attributes)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AnimationURL) > 0 {
+		i -= len(m.AnimationURL)
+		copy(dAtA[i:], m.AnimationURL)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.AnimationURL)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.Schema) > 0 {
-		i -= len(m.Schema)
-		copy(dAtA[i:], m.Schema)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Schema)))
+	if len(m.ExternalURL) > 0 {
+		i -= len(m.ExternalURL)
+		copy(dAtA[i:], m.ExternalURL)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ExternalURL)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
+	if len(m.Image) > 0 {
+		i -= len(m.Image)
+		copy(dAtA[i:], m.Image)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Image)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Id)))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -1385,182 +1637,382 @@ func (m *Collection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TraitCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgIssueDenom) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TraitCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TraitCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.Count != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Schema)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgTransferNFT) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *HistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.URI)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.Action != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.Sequence != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgEditNFT) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TokenHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.URI)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMintNFT) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.URI)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	{
+		size, err := m.DepositPerByte.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgBurnNFT) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TokenDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BaseNFT) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Hidden) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Hidden) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Hidden) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Name)
-	if l > 0 {
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomTransferPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomTransferPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomTransferPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Policy) > 0 {
+		i -= len(m.Policy)
+		copy(dAtA[i:], m.Policy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Policy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PolicyAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PolicyAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PolicyAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.List) > 0 {
+		i -= len(m.List)
+		copy(dAtA[i:], m.List)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.List)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BaseNFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
 	l = len(m.URI)
@@ -1575,6 +2027,37 @@ func (m *BaseNFT) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.URIHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Attribute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1600,6 +2083,57 @@ func (m *Denom) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.URI)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.URIHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.HistoryRetention != 0 {
+		n += 1 + sovTypes(uint64(m.HistoryRetention))
+	}
+	if m.Revocable {
+		n += 2
+	}
+	if m.MetadataTemplate != nil {
+		l = m.MetadataTemplate.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.BaseURI)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *MetadataTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Image)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ExternalURL)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.AnimationURL)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Attributes)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1658,44 +2192,761 @@ func (m *Collection) Size() (n int) {
 	return n
 }
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *TraitCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovTypes(uint64(m.Count))
+	}
+	return n
 }
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *HistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTypes(uint64(m.Sequence))
+	}
+	if m.Action != 0 {
+		n += 1 + sovTypes(uint64(m.Action))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	return n
 }
-func (m *MsgIssueDenom) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
+
+func (m *TokenHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DepositPerByte.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *TokenDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *Hidden) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomTransferPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Policy)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *PolicyAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.List)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BaseNFT) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseNFT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URIHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Attribute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Attribute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Attribute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Denom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Denom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Denom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Creator = append(m.Creator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Creator == nil {
+				m.Creator = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgIssueDenom: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgIssueDenom: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1723,11 +2974,11 @@ func (m *MsgIssueDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.URI = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1755,13 +3006,52 @@ func (m *MsgIssueDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.URIHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryRetention", wireType)
+			}
+			m.HistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revocable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revocable = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataTemplate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1771,29 +3061,33 @@ func (m *MsgIssueDenom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schema = string(dAtA[iNdEx:postIndex])
+			if m.MetadataTemplate == nil {
+				m.MetadataTemplate = &MetadataTemplate{}
+			}
+			if err := m.MetadataTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseURI", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1803,25 +3097,23 @@ func (m *MsgIssueDenom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
-			if m.Sender == nil {
-				m.Sender = []byte{}
-			}
+			m.BaseURI = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1847,7 +3139,7 @@ func (m *MsgIssueDenom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferNFT) Unmarshal(dAtA []byte) error {
+func (m *MetadataTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1870,15 +3162,15 @@ func (m *MsgTransferNFT) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferNFT: wiretype end group for non-group")
+			return fmt.Errorf("proto: MetadataTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MetadataTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1906,11 +3198,11 @@ func (m *MsgTransferNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1938,11 +3230,11 @@ func (m *MsgTransferNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Image = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1970,11 +3262,11 @@ func (m *MsgTransferNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ExternalURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnimationURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2002,11 +3294,11 @@ func (m *MsgTransferNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URI = string(dAtA[iNdEx:postIndex])
+			m.AnimationURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2034,75 +3326,7 @@ func (m *MsgTransferNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
-			if m.Sender == nil {
-				m.Sender = []byte{}
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Recipient = append(m.Recipient[:0], dAtA[iNdEx:postIndex]...)
-			if m.Recipient == nil {
-				m.Recipient = []byte{}
-			}
+			m.Attributes = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2128,7 +3352,7 @@ func (m *MsgTransferNFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditNFT) Unmarshal(dAtA []byte) error {
+func (m *IDCollection) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2151,15 +3375,15 @@ func (m *MsgEditNFT) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditNFT: wiretype end group for non-group")
+			return fmt.Errorf("proto: IDCollection: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IDCollection: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2187,11 +3411,11 @@ func (m *MsgEditNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2219,77 +3443,66 @@ func (m *MsgEditNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Owner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URI = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Owner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Owner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2299,29 +3512,31 @@ func (m *MsgEditNFT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = string(dAtA[iNdEx:postIndex])
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IDCollections", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2331,24 +3546,24 @@ func (m *MsgEditNFT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
-			if m.Sender == nil {
-				m.Sender = []byte{}
+			m.IDCollections = append(m.IDCollections, IDCollection{})
+			if err := m.IDCollections[len(m.IDCollections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -2375,7 +3590,7 @@ func (m *MsgEditNFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintNFT) Unmarshal(dAtA []byte) error {
+func (m *Collection) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2398,17 +3613,17 @@ func (m *MsgMintNFT) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintNFT: wiretype end group for non-group")
+			return fmt.Errorf("proto: Collection: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Collection: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2418,29 +3633,30 @@ func (m *MsgMintNFT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			if err := m.Denom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NFTs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2450,59 +3666,82 @@ func (m *MsgMintNFT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			m.NFTs = append(m.NFTs, BaseNFT{})
+			if err := m.NFTs[len(m.NFTs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TraitCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TraitCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TraitCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2530,11 +3769,11 @@ func (m *MsgMintNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URI = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2562,47 +3801,13 @@ func (m *MsgMintNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
-			if m.Sender == nil {
-				m.Sender = []byte{}
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var byteLen int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2612,26 +3817,11 @@ func (m *MsgMintNFT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Count |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Recipient = append(m.Recipient[:0], dAtA[iNdEx:postIndex]...)
-			if m.Recipient == nil {
-				m.Recipient = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2656,7 +3846,7 @@ func (m *MsgMintNFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnNFT) Unmarshal(dAtA []byte) error {
+func (m *HistoryEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2679,17 +3869,17 @@ func (m *MsgBurnNFT) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnNFT: wiretype end group for non-group")
+			return fmt.Errorf("proto: HistoryEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			var stringLen uint64
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2699,29 +3889,35 @@ func (m *MsgBurnNFT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= HistoryAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2731,27 +3927,29 @@ func (m *MsgBurnNFT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.From = append(m.From[:0], dAtA[iNdEx:postIndex]...)
+			if m.From == nil {
+				m.From = []byte{}
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2778,11 +3976,30 @@ func (m *MsgBurnNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
-			if m.Sender == nil {
-				m.Sender = []byte{}
+			m.To = append(m.To[:0], dAtA[iNdEx:postIndex]...)
+			if m.To == nil {
+				m.To = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2807,7 +4024,7 @@ func (m *MsgBurnNFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BaseNFT) Unmarshal(dAtA []byte) error {
+func (m *TokenHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2830,15 +4047,15 @@ func (m *BaseNFT) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BaseNFT: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BaseNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2866,11 +4083,11 @@ func (m *BaseNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.DenomId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2898,13 +4115,13 @@ func (m *BaseNFT) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.TokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2914,29 +4131,84 @@ func (m *BaseNFT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URI = string(dAtA[iNdEx:postIndex])
+			m.Entries = append(m.Entries, HistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositPerByte", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2946,29 +4218,30 @@ func (m *BaseNFT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = string(dAtA[iNdEx:postIndex])
+			if err := m.DepositPerByte.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2978,26 +4251,12 @@ func (m *BaseNFT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
-			if m.Owner == nil {
-				m.Owner = []byte{}
-			}
-			iNdEx = postIndex
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3022,7 +4281,7 @@ func (m *BaseNFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Denom) Unmarshal(dAtA []byte) error {
+func (m *TokenDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3045,15 +4304,15 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Denom: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Denom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3081,11 +4340,11 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.DenomId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3113,45 +4372,13 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.TokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Schema = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3161,24 +4388,23 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = append(m.Creator[:0], dAtA[iNdEx:postIndex]...)
-			if m.Creator == nil {
-				m.Creator = []byte{}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3205,7 +4431,7 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IDCollection) Unmarshal(dAtA []byte) error {
+func (m *Hidden) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3228,15 +4454,15 @@ func (m *IDCollection) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IDCollection: wiretype end group for non-group")
+			return fmt.Errorf("proto: Hidden: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IDCollection: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Hidden: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3264,11 +4490,11 @@ func (m *IDCollection) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.DenomId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3296,7 +4522,7 @@ func (m *IDCollection) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
+			m.TokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3322,7 +4548,7 @@ func (m *IDCollection) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Owner) Unmarshal(dAtA []byte) error {
+func (m *DenomTransferPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3345,17 +4571,17 @@ func (m *Owner) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Owner: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomTransferPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Owner: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomTransferPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3365,31 +4591,29 @@ func (m *Owner) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
-			}
+			m.DenomId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IDCollections", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3399,25 +4623,23 @@ func (m *Owner) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IDCollections = append(m.IDCollections, IDCollection{})
-			if err := m.IDCollections[len(m.IDCollections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Policy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3443,7 +4665,7 @@ func (m *Owner) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Collection) Unmarshal(dAtA []byte) error {
+func (m *PolicyAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3466,17 +4688,17 @@ func (m *Collection) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Collection: wiretype end group for non-group")
+			return fmt.Errorf("proto: PolicyAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Collection: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PolicyAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3486,30 +4708,29 @@ func (m *Collection) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Denom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DenomId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NFTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3519,25 +4740,55 @@ func (m *Collection) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NFTs = append(m.NFTs, BaseNFT{})
-			if err := m.NFTs[len(m.NFTs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.List = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"hash"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// multihash function codes supported by the uri hash
+// (see https://github.com/multiformats/multicodec)
+const (
+	MultihashSHA2256 = 0x12
+	MultihashSHA2512 = 0x13
+)
+
+// ValidateURIHash checks that the uri hash is either empty, a hex encoded
+// sha256 digest or a hex encoded multihash of a supported hash function
+func ValidateURIHash(uriHash string) error {
+	uriHash = strings.TrimSpace(uriHash)
+	if len(uriHash) == 0 || uriHash == DoNotModify {
+		return nil
+	}
+	if _, _, err := ParseURIHash(uriHash); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ParseURIHash decodes the uri hash and returns the hash function able to
+// reproduce it together with the expected digest
+func ParseURIHash(uriHash string) (newHash func() hash.Hash, digest []byte, err error) {
+	bz, err := hex.DecodeString(strings.TrimSpace(uriHash))
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(ErrInvalidURIHash, "invalid uriHash %s, only accepts hex encoded value", uriHash)
+	}
+
+	// a plain sha256 digest
+	if len(bz) == sha256.Size {
+		return sha256.New, bz, nil
+	}
+
+	// <function code><digest length><digest>
+	if len(bz) < 2 || int(bz[1]) != len(bz)-2 {
+		return nil, nil, sdkerrors.Wrapf(ErrInvalidURIHash, "invalid uriHash %s, neither a sha256 digest nor a multihash", uriHash)
+	}
+	switch bz[0] {
+	case MultihashSHA2256:
+		newHash = sha256.New
+	case MultihashSHA2512:
+		newHash = sha512.New
+	default:
+		return nil, nil, sdkerrors.Wrapf(ErrInvalidURIHash, "invalid uriHash %s, unsupported multihash function 0x%x", uriHash, bz[0])
+	}
+	if newHash().Size() != int(bz[1]) {
+		return nil, nil, sdkerrors.Wrapf(ErrInvalidURIHash, "invalid uriHash %s, digest length mismatch", uriHash)
+	}
+	return newHash, bz[2:], nil
+}
+
+// VerifyURIHash checks the content fetched from the uri against the stored uri hash
+func VerifyURIHash(uriHash string, content []byte) error {
+	if len(strings.TrimSpace(uriHash)) == 0 {
+		return sdkerrors.Wrap(ErrInvalidURIHash, "no uriHash to verify against")
+	}
+
+	newHash, expected, err := ParseURIHash(uriHash)
+	if err != nil {
+		return err
+	}
+
+	h := newHash()
+	_, _ = h.Write(content)
+	if actual := h.Sum(nil); !bytes.Equal(actual, expected) {
+		return sdkerrors.Wrapf(ErrURIHashMismatch, "expected %X, got %X", expected, actual)
+	}
+	return nil
+}
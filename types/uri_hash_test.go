@@ -0,0 +1,58 @@
+package types_test
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/irismod/nft/types"
+)
+
+func TestValidateURIHash(t *testing.T) {
+	content := []byte(`{"name":"id1"}`)
+	sha256Sum := sha256.Sum256(content)
+	sha512Sum := sha512.Sum512(content)
+
+	tests := []struct {
+		name    string
+		uriHash string
+		wantErr bool
+	}{
+		{"empty", "", false},
+		{"do not modify", types.DoNotModify, false},
+		{"sha256 digest", hex.EncodeToString(sha256Sum[:]), false},
+		{"sha256 multihash", "1220" + hex.EncodeToString(sha256Sum[:]), false},
+		{"sha512 multihash", "1340" + hex.EncodeToString(sha512Sum[:]), false},
+		{"not hex", "zz", true},
+		{"short digest", hex.EncodeToString(sha256Sum[:20]), true},
+		{"unknown multihash function", "1120" + hex.EncodeToString(sha256Sum[:]), true},
+		{"multihash length mismatch", "1320" + hex.EncodeToString(sha256Sum[:]), true},
+	}
+
+	for _, tt := range tests {
+		err := types.ValidateURIHash(tt.uriHash)
+		if tt.wantErr {
+			require.Error(t, err, tt.name)
+		} else {
+			require.NoError(t, err, tt.name)
+		}
+	}
+}
+
+func TestVerifyURIHash(t *testing.T) {
+	content := []byte(`{"name":"id1"}`)
+	sha256Sum := sha256.Sum256(content)
+	sha512Sum := sha512.Sum512(content)
+
+	require.NoError(t, types.VerifyURIHash(hex.EncodeToString(sha256Sum[:]), content))
+	require.NoError(t, types.VerifyURIHash("1220"+hex.EncodeToString(sha256Sum[:]), content))
+	require.NoError(t, types.VerifyURIHash("1340"+hex.EncodeToString(sha512Sum[:]), content))
+
+	err := types.VerifyURIHash(hex.EncodeToString(sha256Sum[:]), []byte(`{"name":"id2"}`))
+	require.True(t, types.ErrURIHashMismatch.Is(err))
+
+	require.Error(t, types.VerifyURIHash("", content))
+}